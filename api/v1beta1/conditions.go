@@ -26,6 +26,76 @@ const (
 	// OpenShift Lightspeed Operator Status=True condition which indicates if OpenShift Lightspeed is installed and
 	// operational and it can be used by OpenShift AI Lightspeed operator.
 	OpenShiftLightspeedOperatorReadyCondition condition.Type = "OpenShiftLightspeedOperatorReady"
+
+	// ServiceAccountTokenReadyCondition Status=True condition which indicates if the token of the operator managed
+	// ServiceAccount used to access the InferenceService is present and valid.
+	ServiceAccountTokenReadyCondition condition.Type = "ServiceAccountTokenReady"
+
+	// InferenceServiceReadyCondition Status=True condition which indicates if the InferenceService referenced in
+	// InferenceServiceRef is ready to serve requests.
+	InferenceServiceReadyCondition condition.Type = "InferenceServiceReady"
+
+	// ManagedModelReadyCondition Status=True condition which indicates if the ServingRuntime and the
+	// InferenceService of the model managed by the operator are deployed.
+	ManagedModelReadyCondition condition.Type = "ManagedModelReady"
+
+	// ImagePullReadyCondition Status=True condition which indicates if the RAG images can be pulled.
+	ImagePullReadyCondition condition.Type = "ImagePullReady"
+
+	// LlamaStackReadyCondition Status=True condition which indicates if the LlamaStackDistribution referenced in
+	// LlamaStackDistributionRef is ready to serve requests.
+	LlamaStackReadyCondition condition.Type = "LlamaStackReady"
+
+	// RAGSourceReadyCondition Status=True condition which indicates if the content referenced in RAGSource has
+	// been staged into a RAG image.
+	RAGSourceReadyCondition condition.Type = "RAGSourceReady"
+
+	// RAGContentReadyCondition Status=True condition which indicates if the index metadata of the RAG container
+	// image has been discovered.
+	RAGContentReadyCondition condition.Type = "RAGContentReady"
+
+	// GuardrailsReadyCondition Status=True condition which indicates if the GuardrailsOrchestrator the LLM
+	// requests are sent through is ready.
+	GuardrailsReadyCondition condition.Type = "GuardrailsReady"
+
+	// RAGImageUpdateAvailableCondition Status=True condition which indicates that a newer tag of the RAG image
+	// is available. It is False with the Info severity while the RAG image is up to date, so it does not
+	// affect the readiness of the instance.
+	RAGImageUpdateAvailableCondition condition.Type = "UpdateAvailable"
+
+	// ToolsReadyCondition Status=True condition which indicates if the tools set in Tools are configured in OLS
+	// and the RHOAI MCP server deployed by the operator is ready.
+	ToolsReadyCondition condition.Type = "ToolsReady"
+
+	// EgressNetworkPolicyReadyCondition Status=True condition which indicates if the NetworkPolicy restricting
+	// the egress of the OLS app server is up to date with the LLM endpoint.
+	EgressNetworkPolicyReadyCondition condition.Type = "EgressNetworkPolicyReady"
+
+	// LLMClientCertificateReadyCondition Status=True condition which indicates if the client certificate
+	// presented to the LLM provider is valid. It is False with the Warning severity ahead of its expiry.
+	LLMClientCertificateReadyCondition condition.Type = "LLMClientCertificateReady"
+
+	// PausedCondition Status=True condition which indicates that the reconciliation is paused with Paused or
+	// the paused annotation. It is removed when the reconciliation resumes.
+	PausedCondition condition.Type = "Paused"
+
+	// HibernatingCondition Status=True condition which indicates that the OLS app server is scaled down to zero
+	// replicas in a hibernation window. It is removed outside of the windows.
+	HibernatingCondition condition.Type = "Hibernating"
+)
+
+// LightspeedRAGSource Condition Types used by API objects.
+const (
+	// LightspeedRAGSourceIndexReadyCondition Status=True condition which indicates if the documents referenced
+	// in the LightspeedRAGSource have been indexed into a RAG image.
+	LightspeedRAGSourceIndexReadyCondition condition.Type = "IndexReady"
+)
+
+// LightspeedRAGContribution Condition Types used by API objects.
+const (
+	// RAGContributionAcceptedCondition Status=True condition which indicates if the entries of the
+	// LightspeedRAGContribution are added to the OLSConfig.
+	RAGContributionAcceptedCondition condition.Type = "Accepted"
 )
 
 // Common Messages used by API objects.
@@ -36,6 +106,15 @@ const (
 	// OpenShiftAILightspeedReadyMessage
 	OpenShiftAILightspeedReadyMessage = "OpenShift AI Lightspeed created"
 
+	// OpenShiftAILightspeedInvalidSpecMessage
+	OpenShiftAILightspeedInvalidSpecMessage = "OpenShift AI Lightspeed spec is invalid: %s"
+
+	// OpenShiftAILightspeedSystemPromptErrorMessage
+	OpenShiftAILightspeedSystemPromptErrorMessage = "System prompt could not be read: %s"
+
+	// OpenShiftAILightspeedResourceQuotaMessage
+	OpenShiftAILightspeedResourceQuotaMessage = "OLS deployment does not fit within the resource quota: %s"
+
 	// OpenShiftAILightspeedWaitingVectorDBMessage
 	OpenShiftAILightspeedWaitingVectorDBMessage = "Waiting for OpenShiftAILightspeed vector DB pod to become ready"
 
@@ -44,4 +123,136 @@ const (
 
 	// OpenShiftLightspeedOperatorReady
 	OpenShiftLightspeedOperatorReady = "OpenShift Lightspeed operator is ready."
+
+	// ServiceAccountTokenReadyMessage
+	ServiceAccountTokenReadyMessage = "ServiceAccount token for the InferenceService is ready."
+
+	// ServiceAccountTokenErrorMessage
+	ServiceAccountTokenErrorMessage = "ServiceAccount token for the InferenceService could not be created: %s"
+
+	// InferenceServiceReadyMessage
+	InferenceServiceReadyMessage = "InferenceService is ready."
+
+	// InferenceServiceWaitingMessage
+	InferenceServiceWaitingMessage = "Waiting for the InferenceService to become ready: %s"
+
+	// InferenceServiceErrorMessage
+	InferenceServiceErrorMessage = "InferenceService could not be resolved: %s"
+
+	// ManagedModelReadyMessage
+	ManagedModelReadyMessage = "Managed model is deployed."
+
+	// ManagedModelErrorMessage
+	ManagedModelErrorMessage = "Managed model could not be deployed: %s"
+
+	// ImagePullReadyMessage
+	ImagePullReadyMessage = "RAG images can be pulled."
+
+	// ImagePullErrorMessage
+	ImagePullErrorMessage = "RAG image could not be pulled: %s"
+
+	// ImagePullSecretErrorMessage
+	ImagePullSecretErrorMessage = "RAG image pull secret is invalid: %s"
+
+	// LlamaStackReadyMessage
+	LlamaStackReadyMessage = "LlamaStackDistribution is ready."
+
+	// LlamaStackWaitingMessage
+	LlamaStackWaitingMessage = "Waiting for the LlamaStackDistribution to become ready: %s"
+
+	// LlamaStackErrorMessage
+	LlamaStackErrorMessage = "LlamaStackDistribution could not be resolved: %s"
+
+	// RAGSourceReadyMessage
+	RAGSourceReadyMessage = "RAG source staged."
+
+	// RAGSourceWaitingMessage
+	RAGSourceWaitingMessage = "Waiting for the RAG source to be staged."
+
+	// RAGSourceErrorMessage
+	RAGSourceErrorMessage = "RAG source could not be staged: %s"
+
+	// RAGContentReadyMessage
+	RAGContentReadyMessage = "RAG content index metadata discovered."
+
+	// RAGContentWaitingMessage
+	RAGContentWaitingMessage = "Waiting for the RAG content discovery job to complete."
+
+	// RAGContentErrorMessage
+	RAGContentErrorMessage = "RAG content index metadata could not be discovered: %s"
+
+	// RAGImageUpdateAvailableMessage
+	RAGImageUpdateAvailableMessage = "RAG image %s is available."
+
+	// RAGImageUpdatePendingMessage
+	RAGImageUpdatePendingMessage = "RAG image %s is available and will be applied in the next maintenance window."
+
+	// RAGImageUpToDateMessage
+	RAGImageUpToDateMessage = "RAG image %s is up to date."
+
+	// RAGImageUpdateErrorMessage
+	RAGImageUpdateErrorMessage = "RAG image updates could not be checked: %s"
+
+	// GuardrailsReadyMessage
+	GuardrailsReadyMessage = "GuardrailsOrchestrator is ready."
+
+	// GuardrailsWaitingMessage
+	GuardrailsWaitingMessage = "Waiting for the GuardrailsOrchestrator to become ready: %s"
+
+	// GuardrailsErrorMessage
+	GuardrailsErrorMessage = "GuardrailsOrchestrator could not be deployed: %s"
+
+	// ToolsReadyMessage
+	ToolsReadyMessage = "Tool calling is configured."
+
+	// ToolsWaitingMessage
+	ToolsWaitingMessage = "Waiting for the RHOAI MCP server to become ready: %s"
+
+	// ToolsUnsupportedMessage
+	ToolsUnsupportedMessage = "Tool calling is not configured: %s"
+
+	// ToolsErrorMessage
+	ToolsErrorMessage = "RHOAI MCP server could not be deployed: %s"
+
+	// EgressNetworkPolicyReadyMessage
+	EgressNetworkPolicyReadyMessage = "Egress NetworkPolicy is up to date."
+
+	// EgressNetworkPolicyErrorMessage
+	EgressNetworkPolicyErrorMessage = "Egress NetworkPolicy could not be updated: %s"
+
+	// LLMClientCertificateReadyMessage
+	LLMClientCertificateReadyMessage = "LLM client certificate is valid until %s."
+
+	// LLMClientCertificateExpiringMessage
+	LLMClientCertificateExpiringMessage = "LLM client certificate expires on %s, renew it."
+
+	// LLMClientCertificateErrorMessage
+	LLMClientCertificateErrorMessage = "LLM client certificate is invalid: %s"
+
+	// LLMClientCertificateUnsupportedMessage
+	LLMClientCertificateUnsupportedMessage = "LLM client certificate is not configured: %s"
+
+	// PausedMessage
+	PausedMessage = "Reconciliation is paused, no changes are applied."
+
+	// HibernatingMessage
+	HibernatingMessage = "OLS app server is hibernating until %s."
+
+	// OpenShiftAILightspeedHibernatingMessage
+	OpenShiftAILightspeedHibernatingMessage = "OpenShift AI Lightspeed created, the OLS app server is hibernating"
+
+	// LightspeedRAGSourceIndexReadyMessage
+	LightspeedRAGSourceIndexReadyMessage = "Documents indexed."
+
+	// LightspeedRAGSourceIndexWaitingMessage
+	LightspeedRAGSourceIndexWaitingMessage = "Waiting for the documents to be indexed."
+
+	// LightspeedRAGSourceIndexErrorMessage
+	LightspeedRAGSourceIndexErrorMessage = "Documents could not be indexed: %s"
+
+	// RAGContributionAcceptedMessage
+	RAGContributionAcceptedMessage = "RAG contribution added to the OLSConfig of %s."
+
+	// RAGContributionRejectedMessage
+	RAGContributionRejectedMessage = "RAG contribution rejected: %s"
 )
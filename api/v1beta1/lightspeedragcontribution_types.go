@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LightspeedRAGContributionSpec defines the desired state of LightspeedRAGContribution
+type LightspeedRAGContributionSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// RAG entries added to the OLSConfig, in order
+	RAG []RAGContributionEntry `json:"rag"`
+}
+
+// RAGContributionEntry references a vector DB inside of a RAG container image
+type RAGContributionEntry struct {
+	// +kubebuilder:validation:Required
+	// RAG container image. It is pulled in the namespace of the OpenShiftAILightspeed instance, so it has to
+	// be public or covered by its RAGImagePullSecrets.
+	Image string `json:"image"`
+
+	// +kubebuilder:validation:Required
+	// Path of the vector DB inside of the image
+	IndexPath string `json:"indexPath"`
+
+	// +kubebuilder:validation:Optional
+	// ID of the index in the vector DB
+	IndexID string `json:"indexID,omitempty"`
+}
+
+// LightspeedRAGContributionStatus defines the observed state of LightspeedRAGContribution
+type LightspeedRAGContributionStatus struct {
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the most recent generation observed for this object.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Position - position of the first entry of the contribution in the OLSConfig RAG, starting at 0. Only
+	// set while the contribution is accepted.
+	Position *int32 `json:"position,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].status",description="Accepted"
+// +kubebuilder:printcolumn:name="Position",type="integer",JSONPath=".status.position",description="Position"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].message",description="Message"
+
+// LightspeedRAGContribution is the Schema for the lightspeedragcontributions API. It lets application teams
+// add RAG entries to the OLSConfig from their own namespaces. The contributions are accepted from the
+// namespaces allowed in RAGContributions of the OpenShiftAILightspeed instance.
+type LightspeedRAGContribution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LightspeedRAGContributionSpec   `json:"spec,omitempty"`
+	Status LightspeedRAGContributionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LightspeedRAGContributionList contains a list of LightspeedRAGContribution
+type LightspeedRAGContributionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LightspeedRAGContribution `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LightspeedRAGContribution{}, &LightspeedRAGContributionList{})
+}
+
+// IsAccepted - returns true if the entries of the LightspeedRAGContribution are added to the OLSConfig
+func (instance LightspeedRAGContribution) IsAccepted() bool {
+	return instance.Status.Conditions.IsTrue(RAGContributionAcceptedCondition)
+}
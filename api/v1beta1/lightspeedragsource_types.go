@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LightspeedRAGSourceSpec defines the desired state of LightspeedRAGSource
+// +kubebuilder:validation:XValidation:rule="(has(self.git) ? 1 : 0) + (has(self.configMap) ? 1 : 0) + (has(self.pvc) ? 1 : 0) == 1",message="exactly one of git, configMap or pvc must be set"
+type LightspeedRAGSourceSpec struct {
+	// +kubebuilder:validation:Optional
+	// Git repository holding the Markdown and HTML documents
+	Git *GitDocumentSource `json:"git,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// ConfigMap holding the Markdown and HTML documents, one document per key
+	ConfigMap *ConfigMapDocumentSource `json:"configMap,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// PersistentVolumeClaim holding the Markdown and HTML documents
+	PVC *PVCRAGSource `json:"pvc,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Container image indexing the documents into a llama-index vector DB (defaults to the environmental
+	// default indexer image)
+	IndexerImage string `json:"indexerImage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Embedding model used to index the documents (defaults to the embedding model used by OLS)
+	EmbeddingModel string `json:"embeddingModel,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// ID of the index in the vector DB (defaults to the name of the LightspeedRAGSource)
+	IndexID string `json:"indexID,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Revision of the documents. Change it to index the documents again after they were updated in place.
+	Revision string `json:"revision,omitempty"`
+}
+
+// GitDocumentSource references documents stored in a Git repository
+type GitDocumentSource struct {
+	// +kubebuilder:validation:Required
+	// URL of the Git repository
+	URL string `json:"url"`
+
+	// +kubebuilder:validation:Optional
+	// Branch or tag to index (defaults to the default branch of the repository)
+	Ref string `json:"ref,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="/"
+	// Path of the documents inside of the repository
+	Path string `json:"path,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Secret of type kubernetes.io/basic-auth with the credentials of the Git repository
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// ConfigMapDocumentSource references documents stored in a ConfigMap
+type ConfigMapDocumentSource struct {
+	// +kubebuilder:validation:Required
+	// Name of the ConfigMap
+	Name string `json:"name"`
+}
+
+// LightspeedRAGSourceStatus defines the observed state of LightspeedRAGSource
+type LightspeedRAGSourceStatus struct {
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the most recent generation observed for this object.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Image - RAG container image the documents were indexed into
+	Image string `json:"image,omitempty"`
+
+	// IndexPath - path of the vector DB inside of Image
+	IndexPath string `json:"indexPath,omitempty"`
+
+	// IndexID - ID of the index in the vector DB
+	IndexID string `json:"indexID,omitempty"`
+
+	// SourceHash - hash of the spec the image was indexed from
+	SourceHash string `json:"sourceHash,omitempty"`
+
+	// IndexedAt - time the documents were last indexed
+	IndexedAt *metav1.Time `json:"indexedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.image",description="Image"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// LightspeedRAGSource is the Schema for the lightspeedragsources API. The documents it references are indexed
+// into a RAG image that is added to the RAG of the OpenShiftAILightspeed instance in the same namespace.
+type LightspeedRAGSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LightspeedRAGSourceSpec   `json:"spec,omitempty"`
+	Status LightspeedRAGSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LightspeedRAGSourceList contains a list of LightspeedRAGSource
+type LightspeedRAGSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LightspeedRAGSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LightspeedRAGSource{}, &LightspeedRAGSourceList{})
+}
+
+// IsReady - returns true if LightspeedRAGSource is reconciled successfully
+func (instance LightspeedRAGSource) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.ReadyCondition)
+}
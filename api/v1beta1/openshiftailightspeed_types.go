@@ -17,8 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	"os"
+	"strings"
+
 	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
 	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,7 +32,22 @@ const (
 
 	// OpenShiftAILightspeedContainerImage is the fall-back container image for OpenShiftAILightspeed
 	OpenShiftAILightspeedContainerImage = "quay.io/opendatahub-io/openshift-ai-lightspeed-rag-content:rhoai-docs-2025.1"
-	MaxTokensForResponseDefault         = 2048
+	// OpenShiftAILightspeedVLLMImage is the fall-back vLLM container image used to serve the managed model
+	OpenShiftAILightspeedVLLMImage = "quay.io/modh/vllm:rhoai-2.22-cuda"
+	// OpenShiftAILightspeedRAGBaseImage is the fall-back base image of the RAG images staged from RAG sources
+	OpenShiftAILightspeedRAGBaseImage = "registry.access.redhat.com/ubi9/ubi-minimal:latest"
+	// OpenShiftAILightspeedCLIImage is the fall-back image with the oc CLI used to stage RAG sources
+	OpenShiftAILightspeedCLIImage = "registry.redhat.io/openshift4/ose-cli-rhel9:latest"
+	// OpenShiftAILightspeedRAGIndexerImage is the fall-back image indexing the documents of LightspeedRAGSources
+	OpenShiftAILightspeedRAGIndexerImage = "quay.io/opendatahub-io/openshift-ai-lightspeed-rag-indexer:latest"
+	// OpenShiftAILightspeedRHOAIMCPServerImage is the fall-back image of the read-only RHOAI MCP server
+	OpenShiftAILightspeedRHOAIMCPServerImage = "quay.io/opendatahub-io/rhoai-mcp-server:latest"
+	// EmbeddingModelDefault is the embedding model OLS queries the vector DBs with
+	EmbeddingModelDefault         = "sentence-transformers/all-mpnet-base-v2"
+	MaxTokensForResponseDefault   = 2048
+	TokenExpirationSecondsDefault = 3600
+	// ManagedModelAcceleratorResourceDefault is the accelerator requested by the managed model server
+	ManagedModelAcceleratorResourceDefault = "nvidia.com/gpu"
 )
 
 // OpenShiftAILightspeedSpec defines the desired state of OpenShiftAILightspeed
@@ -35,35 +55,95 @@ type OpenShiftAILightspeedSpec struct {
 	OpenShiftAILightspeedCore `json:",inline"`
 
 	// +kubebuilder:validation:Optional
-	// ContainerImage for the OpenShift AI Lightspeed RAG container (will be set to environmental default if empty)
+	// ContainerImage for the OpenShift AI Lightspeed RAG container (will be set to the image matching the
+	// installed OpenShift AI version or to the environmental default if empty)
 	RAGImage string `json:"ragImage"`
+
+	// +kubebuilder:validation:Optional
+	// RAG content stored in a PersistentVolumeClaim or an OCI artifact instead of a RAG container image. The
+	// operator stages the content into a RAG image in the namespace of the OpenShiftAILightspeed instance.
+	// Cannot be combined with RAGImage.
+	RAGSource *RAGSourceSpec `json:"ragSource,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Namespaces LightspeedRAGContributions are accepted from. Without it no contribution is accepted.
+	RAGContributions *RAGContributionsSpec `json:"ragContributions,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Secrets with the credentials of the registries hosting the RAG images. Secrets from other namespaces
+	// are copied into the namespace of the OpenShiftAILightspeed instance.
+	RAGImagePullSecrets []SecretReference `json:"ragImagePullSecrets,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Track updates of the RAG image tag. The tag is resolved to a digest which OLS is pinned to. When
+	// enabled, the tag is resolved again periodically and OLS moves to the new digest, otherwise the
+	// digest only changes together with the RAG image.
+	TrackRAGImageUpdates bool `json:"trackRAGImageUpdates,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Periodically check the repository of the RAG image for newer tags and optionally apply them in a
+	// maintenance window. Cannot be combined with RAGSource.
+	RAGImageUpdates *RAGImageUpdatesSpec `json:"ragImageUpdates,omitempty"`
 }
 
 // OpenShiftAILightspeedCore defines the desired state of OpenShiftAILightspeed
 type OpenShiftAILightspeedCore struct {
-	// +kubebuilder:validation:Required
-	// URL pointing to the LLM
-	LLMEndpoint string `json:"llmEndpoint"`
+	// +kubebuilder:validation:Optional
+	// URL pointing to the LLM. Required unless InferenceServiceRef is set.
+	LLMEndpoint string `json:"llmEndpoint,omitempty"`
 
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
+	// KServe InferenceService serving the LLM. When set, the LLM URL, the model name and the CA bundle are
+	// resolved from the InferenceService instead of LLMEndpoint, ModelName and TLSCACertBundle.
+	InferenceServiceRef *InferenceServiceReference `json:"inferenceServiceRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Llama Stack distribution serving the LLM. When set, the LLM URL and the model name are resolved from
+	// the OpenAI compatible API of the LlamaStackDistribution instead of LLMEndpoint and ModelName.
+	LlamaStackDistributionRef *LlamaStackDistributionReference `json:"llamaStackDistributionRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Model deployed and managed by the operator with KServe. When set, the model is configured as the
+	// rhoai_vllm provider instead of LLMEndpoint and it is deleted together with the instance.
+	ManagedModel *ManagedModelSpec `json:"managedModel,omitempty"`
+
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=azure_openai;bam;openai;watsonx;rhoai_vllm;rhelai_vllm;fake_provider
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Provider Type"
-	// Type of the provider serving the LLM
-	LLMEndpointType string `json:"llmEndpointType"`
+	// Type of the provider serving the LLM. Required unless ManagedModel or LlamaStackDistributionRef is set.
+	LLMEndpointType string `json:"llmEndpointType,omitempty"`
 
-	// +kubebuilder:validation:Required
-	// Name of the model to use at the API endpoint provided in LLMEndpoint
-	ModelName string `json:"modelName"`
+	// +kubebuilder:validation:Optional
+	// Name of the model to use at the API endpoint provided in LLMEndpoint. Required unless
+	// InferenceServiceRef is set, in which case it defaults to the name of the InferenceService, or
+	// LlamaStackDistributionRef is set, in which case it defaults to the first model of the distribution.
+	ModelName string `json:"modelName,omitempty"`
 
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// Secret name containing API token for the LLMEndpoint. The key for the field
-	// in the secret that holds the token should be "apitoken".
-	LLMCredentials string `json:"llmCredentials"`
+	// in the secret that holds the token should be "apitoken". Required unless
+	// LLMServiceAccountAuth is set.
+	LLMCredentials string `json:"llmCredentials,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Authenticate against a KServe InferenceService with a token of a ServiceAccount
+	// managed by the operator instead of the token stored in LLMCredentials
+	LLMServiceAccountAuth *ServiceAccountAuthSpec `json:"llmServiceAccountAuth,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Guardrails applied to the LLM requests by a TrustyAI GuardrailsOrchestrator. When set, OLS sends
+	// the requests to the gateway of the orchestrator instead of LLMEndpoint.
+	Guardrails *GuardrailsSpec `json:"guardrails,omitempty"`
 
 	// +kubebuilder:validation:Optional
 	// Configmap name containing a CA Certificates bundle
 	TLSCACertBundle string `json:"tlsCACertBundle"`
 
+	// +kubebuilder:validation:Optional
+	// Secret name of type kubernetes.io/tls holding the client certificate ("tls.crt") and its private key
+	// ("tls.key") OLS presents to the LLM provider for mutual TLS. Cannot be combined with Guardrails.
+	LLMClientCertificate string `json:"llmClientCertificate,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// MaxTokensForResponse defines the maximum number of tokens to be used for the response generation
 	MaxTokensForResponse int `json:"maxTokensForResponse,omitempty"`
@@ -97,6 +177,512 @@ type OpenShiftAILightspeedCore struct {
 	// +kubebuilder:validation:Optional
 	// Disable conversation transcripts collection
 	TranscriptsDisabled bool `json:"transcriptsDisabled,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Additional instructions for the LLM (e.g. internal policies to mention), appended to the system prompt
+	// of OpenShift AI Lightspeed
+	SystemPrompt *SystemPromptSpec `json:"systemPrompt,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Filters redacting the queries before they are sent to the LLM, applied in order
+	QueryFilters []QueryFilter `json:"queryFilters,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Deployment settings of the OLS app server (defaults to the ones of the OLS operator)
+	OLSServer *OLSServerSpec `json:"olsServer,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Settings of the Postgres conversation cache of OLS (defaults to the ones of the OLS operator)
+	ConversationCache *ConversationCacheSpec `json:"conversationCache,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Token quotas limiting the LLM usage of each user and of the whole cluster. Quotas require
+	// conversationCache.storageSize, as OLS stores the token usage in the conversation cache.
+	Quotas *QuotasSpec `json:"quotas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Tools OLS lets the LLM call to look at the cluster, e.g. the notebooks, pipelines and model servers of
+	// the user. Tool calling requires a recent OLS operator; it is not configured on older versions.
+	Tools *ToolsSpec `json:"tools,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Users and groups allowed to query OLS. The operator binds them to the OLS query ClusterRole.
+	Access *AccessSpec `json:"access,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Restrict the egress of the OLS app server with a NetworkPolicy to the LLM, the MCP servers, DNS, the
+	// API server and the pods of its namespace
+	RestrictEgress bool `json:"restrictEgress,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Settings of the OLS operator pod, rendered into the config of the Subscription of the OLS operator
+	// installed by the operator
+	OLSOperator *OLSOperatorSpec `json:"olsOperator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Pause the reconciliation: the operator leaves the OLSConfig, the OLS operator and all the other
+	// objects it manages untouched, including on deletion, until it is unset. The
+	// openshift-ai.io/lightspeed-paused: "true" annotation has the same effect.
+	Paused bool `json:"paused,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Recurring windows during which the OLS app server is scaled down to zero replicas, e.g. at night on
+	// development clusters. The replicas are restored at the end of the windows.
+	Hibernation *HibernationSpec `json:"hibernation,omitempty"`
+}
+
+// HibernationSpec defines when the OLS app server hibernates
+type HibernationSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// Windows during which the OLS app server hibernates, each with its own time zone
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+// AccessSpec defines the users and groups allowed to query OLS
+type AccessSpec struct {
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// +kubebuilder:validation:items:MinLength=1
+	// Names of the users allowed to query OLS
+	Users []string `json:"users,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// +kubebuilder:validation:items:MinLength=1
+	// Names of the groups allowed to query OLS
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ToolsSpec defines the tools OLS lets the LLM call
+type ToolsSpec struct {
+	// +kubebuilder:validation:Optional
+	// Let OLS inspect the cluster with the read-only OpenShift MCP server of the OLS operator. The queries run
+	// with the permissions of the user asking.
+	Introspection bool `json:"introspection,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Deploy a read-only MCP server exposing the OpenShift AI resources (notebooks, pipelines, model servers,
+	// ...) and register it in OLS
+	RHOAIMCPServer *RHOAIMCPServerSpec `json:"rhoaiMCPServer,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Additional MCP servers OLS calls the tools of
+	MCPServers []MCPServer `json:"mcpServers,omitempty"`
+}
+
+// RHOAIMCPServerSpec defines the read-only RHOAI MCP server deployed by the operator
+type RHOAIMCPServerSpec struct {
+	// +kubebuilder:validation:Optional
+	// Image of the MCP server (defaults to the one of the operator)
+	Image string `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Compute resources of the MCP server
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// MCPServer defines an MCP server reachable over the streamable HTTP transport
+type MCPServer struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// Name of the MCP server
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// URL of the MCP endpoint (e.g. http://my-mcp-server.my-namespace.svc:8080/mcp)
+	URL string `json:"url"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Seconds OLS waits for a tool call to complete (defaults to the one of OLS)
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// QuotasSpec defines the token quotas OLS enforces
+// +kubebuilder:validation:XValidation:rule="has(self.user) || has(self.cluster)",message="at least one of user or cluster must be set"
+type QuotasSpec struct {
+	// +kubebuilder:validation:Optional
+	// Tokens each user can consume within a period
+	User *TokenQuota `json:"user,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Tokens all the users together can consume within a period
+	Cluster *TokenQuota `json:"cluster,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Record the tokens consumed by each query in the conversation cache
+	TokenHistory bool `json:"tokenHistory,omitempty"`
+}
+
+// TokenQuota defines a token budget that is reset periodically
+type TokenQuota struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// Number of tokens that can be consumed within a period
+	Limit int64 `json:"limit"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1 day"
+	// +kubebuilder:validation:Pattern=`^[1-9][0-9]* (minute|hour|day|week|month)s?$`
+	// Period after which the budget is reset (e.g. 1 day, 4 weeks)
+	Period string `json:"period,omitempty"`
+}
+
+// OLSServerSpec defines the deployment settings of the OLS app server
+type OLSServerSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Number of replicas of the OLS app server
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Compute resources of the OLS app server container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Node selector of the OLS app server pods
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Tolerations of the OLS app server pods
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// OLSOperatorSpec defines the settings of the OLS operator pod
+type OLSOperatorSpec struct {
+	// +kubebuilder:validation:Optional
+	// Compute resources of the OLS operator container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Node selector of the OLS operator pod
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Tolerations of the OLS operator pod
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Environment variables of the OLS operator container. They take precedence over the cluster-wide proxy
+	// settings.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Volumes of the OLS operator pod
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Mounts of the Volumes in the OLS operator container
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+}
+
+// ConversationCacheSpec defines the settings of the Postgres conversation cache of OLS
+type ConversationCacheSpec struct {
+	// +kubebuilder:validation:Optional
+	// Compute resources of the Postgres container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Node selector of the Postgres pod
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Tolerations of the Postgres pod
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Size of the PersistentVolumeClaim storing the conversations
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// StorageClass of the PersistentVolumeClaim storing the conversations (defaults to the default
+	// StorageClass of the cluster)
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=262143
+	// Maximum number of concurrent connections to Postgres, which limits the conversations served at the same
+	// time
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(kB|MB|GB)$`
+	// Memory Postgres uses for caching the conversations (e.g. 256MB)
+	SharedBuffers string `json:"sharedBuffers,omitempty"`
+}
+
+// SystemPromptSpec defines the additional system prompt, either inline or stored in a ConfigMap
+// +kubebuilder:validation:XValidation:rule="has(self.inline) != has(self.configMapRef)",message="exactly one of inline or configMapRef must be set"
+type SystemPromptSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Additional system prompt
+	Inline string `json:"inline,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Key of a ConfigMap in the namespace of the OpenShiftAILightspeed instance holding the additional system
+	// prompt
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+}
+
+// ConfigMapKeyReference references a key of a ConfigMap
+type ConfigMapKeyReference struct {
+	// +kubebuilder:validation:Required
+	// Name of the ConfigMap
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="prompt"
+	// Key of the ConfigMap
+	Key string `json:"key,omitempty"`
+}
+
+// QueryFilter defines a regular expression redacted from the queries
+type QueryFilter struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$`
+	// Name of the filter
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Regular expression matching the text to redact (e.g. \b[0-9]{8,12}\b for account numbers)
+	Pattern string `json:"pattern"`
+
+	// +kubebuilder:validation:Optional
+	// Text the matches are replaced with (defaults to removing them)
+	ReplaceWith string `json:"replaceWith,omitempty"`
+}
+
+// ServiceAccountAuthSpec defines how the operator authenticates OLS against a KServe InferenceService
+// served with authorization enabled
+type ServiceAccountAuthSpec struct {
+	// +kubebuilder:validation:Optional
+	// InferenceService the operator managed ServiceAccount is granted access to (defaults to
+	// InferenceServiceRef)
+	InferenceService *InferenceServiceReference `json:"inferenceService,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=600
+	// Requested lifetime of the ServiceAccount token in seconds. The token is rotated
+	// before it expires.
+	TokenExpirationSeconds int64 `json:"tokenExpirationSeconds,omitempty"`
+}
+
+// ManagedModelSpec defines the model the operator deploys with KServe
+type ManagedModelSpec struct {
+	// +kubebuilder:validation:Required
+	// URI of the model to serve (e.g. oci://registry.redhat.io/rhelai1/modelcar-granite-3-1-8b-instruct:1.5
+	// or pvc://models/granite)
+	StorageURI string `json:"storageURI"`
+
+	// +kubebuilder:validation:Optional
+	// Container image of the vLLM runtime serving the model (will be set to environmental default if empty)
+	RuntimeImage string `json:"runtimeImage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Additional arguments passed to vLLM (e.g. --max-model-len=8192)
+	Args []string `json:"args,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Compute resources of the model server (defaults to a single accelerator of AcceleratorResource)
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="nvidia.com/gpu"
+	// Extended resource name of the accelerator requested by the model server when Resources is not set
+	// (e.g. amd.com/gpu or habana.ai/gaudi)
+	AcceleratorResource string `json:"acceleratorResource,omitempty"`
+}
+
+// GuardrailsSpec defines the TrustyAI GuardrailsOrchestrator the LLM requests are sent through
+type GuardrailsSpec struct {
+	// +kubebuilder:validation:Optional
+	// Name of an existing GuardrailsOrchestrator in the namespace of the OpenShiftAILightspeed instance.
+	// When empty the operator creates and manages a GuardrailsOrchestrator.
+	ExistingOrchestrator string `json:"existingOrchestrator,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={"email","ssn","credit-card"}
+	// PII patterns matched by the built-in regex detector of the operator managed GuardrailsOrchestrator
+	RegexDetectors []string `json:"regexDetectors,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Additional detectors (e.g. prompt injection) used by the operator managed GuardrailsOrchestrator
+	Detectors []GuardrailsDetector `json:"detectors,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="lightspeed"
+	// Name of the gateway route OLS sends the requests to. The operator managed GuardrailsOrchestrator
+	// applies all the detectors on this route.
+	Route string `json:"route,omitempty"`
+}
+
+// GuardrailsDetector defines a detector served outside of the GuardrailsOrchestrator
+type GuardrailsDetector struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9_]*[a-z0-9])?$`
+	// Name of the detector
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// URL of the detector service (e.g.
+	// http://prompt-injection-detector-predictor.models.svc.cluster.local:8000)
+	URL string `json:"url"`
+
+	// +kubebuilder:validation:Optional
+	// Detection threshold between 0 and 1 (defaults to the detector's own threshold)
+	Threshold string `json:"threshold,omitempty"`
+}
+
+// InferenceServiceReference references a KServe InferenceService
+type InferenceServiceReference struct {
+	// +kubebuilder:validation:Required
+	// Name of the InferenceService
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Namespace of the InferenceService (defaults to the namespace of the OpenShiftAILightspeed instance)
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RAGSourceSpec defines where the RAG content is staged from
+// +kubebuilder:validation:XValidation:rule="has(self.pvc) != has(self.ociArtifact)",message="exactly one of pvc or ociArtifact must be set"
+type RAGSourceSpec struct {
+	// +kubebuilder:validation:Optional
+	// PersistentVolumeClaim in the namespace of the OpenShiftAILightspeed instance holding the vector DB
+	PVC *PVCRAGSource `json:"pvc,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// OCI artifact holding the vector DB
+	OCIArtifact *OCIArtifactRAGSource `json:"ociArtifact,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Revision of the content. Change it to stage the content again after it was updated in place.
+	Revision string `json:"revision,omitempty"`
+}
+
+// PVCRAGSource references the vector DB stored in a PersistentVolumeClaim
+type PVCRAGSource struct {
+	// +kubebuilder:validation:Required
+	// Name of the PersistentVolumeClaim
+	ClaimName string `json:"claimName"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="/"
+	// Path of the vector DB inside of the volume
+	Path string `json:"path,omitempty"`
+}
+
+// OCIArtifactRAGSource references the vector DB stored in an OCI artifact
+type OCIArtifactRAGSource struct {
+	// +kubebuilder:validation:Required
+	// Reference of the OCI artifact (e.g. registry.example.com/docs/vector-db:v1). It is pulled with the
+	// RAGImagePullSecrets.
+	Reference string `json:"reference"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="/"
+	// Path of the vector DB inside of the artifact
+	Path string `json:"path,omitempty"`
+}
+
+// RAGContributionsSpec defines the namespaces LightspeedRAGContributions are accepted from and their order
+type RAGContributionsSpec struct {
+	// +kubebuilder:validation:Optional
+	// Namespaces the contributions are accepted from. The contributions are added to the OLSConfig in the order
+	// of their namespace in this list, after the built-in RAG and the LightspeedRAGSources.
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Selector of additional namespaces the contributions are accepted from. Their contributions follow the
+	// ones of AllowedNamespaces, ordered by namespace.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// RAGImageUpdatePolicy defines what happens when a newer tag of the RAG image is found
+type RAGImageUpdatePolicy string
+
+const (
+	// RAGImageUpdatePolicyNotify - newer tags are only reported in the UpdateAvailable condition
+	RAGImageUpdatePolicyNotify RAGImageUpdatePolicy = "Notify"
+	// RAGImageUpdatePolicyAutoApply - newer tags are applied in the maintenance window
+	RAGImageUpdatePolicyAutoApply RAGImageUpdatePolicy = "AutoApply"
+)
+
+// RAGImageUpdatesSpec defines how newer tags of the RAG image are checked for and applied
+// +kubebuilder:validation:XValidation:rule="!has(self.policy) || self.policy != 'AutoApply' || has(self.maintenanceWindow)",message="maintenanceWindow is required with the AutoApply policy"
+type RAGImageUpdatesSpec struct {
+	// +kubebuilder:validation:Optional
+	// Regular expression the newer tags must match (defaults to the tags of the OpenShift AI version series
+	// installed in the cluster, e.g. 2.22 and 2.22.1 for OpenShift AI 2.22)
+	TagPattern string `json:"tagPattern,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	// Interval between the checks for newer tags
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Notify
+	// +kubebuilder:validation:Enum=Notify;AutoApply
+	// What happens when a newer tag is found. Notify only reports it, AutoApply moves OLS to it in the
+	// maintenance window.
+	Policy RAGImageUpdatePolicy `json:"policy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Window the newer tags are applied in with the AutoApply policy
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window
+type MaintenanceWindow struct {
+	// +kubebuilder:validation:Required
+	// Start of the window in the cron format (minute hour day-of-month month day-of-week), e.g. "0 2 * * 6"
+	// for every Saturday at 2:00
+	Schedule string `json:"schedule"`
+
+	// +kubebuilder:validation:Required
+	// Length of the window, at most 7 days
+	Duration metav1.Duration `json:"duration"`
+
+	// +kubebuilder:validation:Optional
+	// IANA time zone of the schedule (defaults to UTC)
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// SecretReference references a Secret
+type SecretReference struct {
+	// +kubebuilder:validation:Required
+	// Name of the Secret
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Namespace of the Secret (defaults to the namespace of the OpenShiftAILightspeed instance)
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// LlamaStackDistributionReference references a LlamaStackDistribution
+type LlamaStackDistributionReference struct {
+	// +kubebuilder:validation:Required
+	// Name of the LlamaStackDistribution
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Namespace of the LlamaStackDistribution (defaults to the namespace of the OpenShiftAILightspeed instance)
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // OpenShiftAILightspeedStatus defines the observed state of OpenShiftAILightspeed
@@ -106,6 +692,177 @@ type OpenShiftAILightspeedStatus struct {
 
 	// ObservedGeneration - the most recent generation observed for this object.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// InferenceService - LLM settings resolved from the InferenceService referenced in InferenceServiceRef
+	InferenceService *InferenceServiceStatus `json:"inferenceService,omitempty"`
+
+	// LlamaStack - LLM settings resolved from the LlamaStackDistribution referenced in
+	// LlamaStackDistributionRef
+	LlamaStack *LlamaStackStatus `json:"llamaStack,omitempty"`
+
+	// InferenceServiceAccessNamespace - namespace of the Role and the RoleBinding allowing the operator managed
+	// ServiceAccount to query the InferenceService
+	InferenceServiceAccessNamespace string `json:"inferenceServiceAccessNamespace,omitempty"`
+
+	// RHOAIVersion - version of OpenShift AI installed in the cluster
+	RHOAIVersion string `json:"rhoaiVersion,omitempty"`
+
+	// RAGDocsVersion - OpenShift AI version of the documentation in the RAG container image selected for
+	// RHOAIVersion. Empty when the RAG image is set in the spec or no image matches RHOAIVersion.
+	RAGDocsVersion string `json:"ragDocsVersion,omitempty"`
+
+	// RAGSource - RAG image the content referenced in RAGSource was staged into
+	RAGSource *RAGSourceStatus `json:"ragSource,omitempty"`
+
+	// RAGImageUpdate - newer tags of the RAG image found with RAGImageUpdates
+	RAGImageUpdate *RAGImageUpdateStatus `json:"ragImageUpdate,omitempty"`
+
+	// RAG - index metadata discovered in the RAG container image
+	RAG *RAGStatus `json:"rag,omitempty"`
+
+	// RAGImages - references of the RAG image OLS is configured with, as selected, staged or updated by the
+	// operator and as pinned to its digest. Pods failing to pull them are reported in ImagePullReady.
+	RAGImages []string `json:"ragImages,omitempty"`
+
+	// AdditionalRAG - RAG images indexed from the LightspeedRAGSources in the namespace of the instance,
+	// followed by the RAG images of the accepted LightspeedRAGContributions
+	AdditionalRAG []AdditionalRAGStatus `json:"additionalRAG,omitempty"`
+
+	// Guardrails - settings resolved from the GuardrailsOrchestrator the LLM requests are sent through
+	Guardrails *GuardrailsStatus `json:"guardrails,omitempty"`
+
+	// AccessSubjects - users, groups and ServiceAccounts bound to the OLS query ClusterRole, including the
+	// bindings not managed by the operator
+	AccessSubjects []AccessSubject `json:"accessSubjects,omitempty"`
+
+	// Proxy - cluster-wide proxy adopted by OLS
+	Proxy *ProxyStatus `json:"proxy,omitempty"`
+
+	// LLMClientCertificateNotAfter - expiry of the client certificate presented to the LLM provider
+	LLMClientCertificateNotAfter *metav1.Time `json:"llmClientCertificateNotAfter,omitempty"`
+}
+
+// ProxyStatus contains the cluster-wide proxy settings adopted by OLS
+type ProxyStatus struct {
+	// HTTPProxy - proxy of the HTTP requests, without credentials
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy - proxy of the HTTPS requests, without credentials
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy - destinations that are not proxied
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// CACertBundle - name of the ConfigMap merging the trusted CA bundle of the cluster with the CA bundle of
+	// the LLM
+	CACertBundle string `json:"caCertBundle,omitempty"`
+}
+
+// AccessSubject is a subject allowed to query OLS
+type AccessSubject struct {
+	// Kind - User, Group or ServiceAccount
+	Kind string `json:"kind"`
+
+	// Name of the subject, prefixed with the namespace for ServiceAccounts
+	Name string `json:"name"`
+
+	// ClusterRoleBinding - name of the ClusterRoleBinding binding the subject
+	ClusterRoleBinding string `json:"clusterRoleBinding"`
+}
+
+// LlamaStackStatus contains the LLM settings resolved from a LlamaStackDistribution
+type LlamaStackStatus struct {
+	// URL of the OpenAI compatible API of the LlamaStackDistribution
+	URL string `json:"url,omitempty"`
+
+	// Models - models served by the LlamaStackDistribution
+	Models []string `json:"models,omitempty"`
+
+	// ResourceVersion - resource version of the LlamaStackDistribution the models were listed for
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+}
+
+// RAGSourceStatus contains the RAG image a RAG source was staged into
+type RAGSourceStatus struct {
+	// Image - RAG container image the content was staged into
+	Image string `json:"image,omitempty"`
+
+	// SourceHash - hash of the RAGSource the image was staged from
+	SourceHash string `json:"sourceHash,omitempty"`
+}
+
+// RAGImageUpdateStatus contains the newer tags found in the repository of the RAG image
+type RAGImageUpdateStatus struct {
+	// BaseImage - RAG image the updates are checked for
+	BaseImage string `json:"baseImage,omitempty"`
+
+	// LatestImage - newest RAG image matching the tag pattern that has not been applied yet
+	LatestImage string `json:"latestImage,omitempty"`
+
+	// AppliedImage - newer RAG image applied in a maintenance window. OLS uses it instead of BaseImage until
+	// the RAG image changes.
+	AppliedImage string `json:"appliedImage,omitempty"`
+
+	// LastCheckTime - time the repository of the RAG image was last checked for newer tags
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// AdditionalRAGStatus contains a RAG image added to the RAG of the instance
+type AdditionalRAGStatus struct {
+	// Source - name of the LightspeedRAGSource the image was indexed from, or namespace/name of the
+	// LightspeedRAGContribution that added it
+	Source string `json:"source"`
+
+	// Image - RAG container image
+	Image string `json:"image"`
+
+	// IndexPath - path of the vector DB inside of Image
+	IndexPath string `json:"indexPath"`
+
+	// IndexID - ID of the index in the vector DB
+	IndexID string `json:"indexID,omitempty"`
+}
+
+// RAGStatus contains the index metadata discovered in a RAG container image
+type RAGStatus struct {
+	// Image - RAG container image the metadata was discovered in
+	Image string `json:"image,omitempty"`
+
+	// Digest - digest the RAG container image was resolved to
+	Digest string `json:"digest,omitempty"`
+
+	// ResolvedAt - time the RAG container image was resolved to Digest
+	ResolvedAt *metav1.Time `json:"resolvedAt,omitempty"`
+
+	// IndexPath - path of the vector DB inside of the image
+	IndexPath string `json:"indexPath,omitempty"`
+
+	// IndexID - ID of the index in the vector DB
+	IndexID string `json:"indexID,omitempty"`
+
+	// EmbeddingModel - embedding model the vector DB was built with
+	EmbeddingModel string `json:"embeddingModel,omitempty"`
+}
+
+// GuardrailsStatus contains the settings resolved from a TrustyAI GuardrailsOrchestrator
+type GuardrailsStatus struct {
+	// Orchestrator - name of the GuardrailsOrchestrator
+	Orchestrator string `json:"orchestrator,omitempty"`
+
+	// GatewayURL - URL of the OpenAI compatible API of the gateway route OLS sends the requests to
+	GatewayURL string `json:"gatewayURL,omitempty"`
+}
+
+// InferenceServiceStatus contains the LLM settings resolved from a KServe InferenceService
+type InferenceServiceStatus struct {
+	// URL of the OpenAI compatible API of the InferenceService predictor
+	URL string `json:"url,omitempty"`
+
+	// ModelName - name of the model served by the InferenceService
+	ModelName string `json:"modelName,omitempty"`
+
+	// CACertBundle - name of the ConfigMap containing the CA bundle that signed the predictor certificate
+	CACertBundle string `json:"caCertBundle,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -141,8 +898,21 @@ func (instance OpenShiftAILightspeed) IsReady() bool {
 }
 
 type OpenShiftAILightspeedDefaults struct {
-	RAGImageURL          string
-	MaxTokensForResponse int
+	RAGImageURL string
+	// RAGImageURLs maps OpenShift AI versions (major.minor) to the RAG image with their documentation
+	RAGImageURLs map[string]string
+	// RAGBaseImageURL is the base of the RAG images staged from RAG sources
+	RAGBaseImageURL string
+	// CLIImageURL is the image with the oc CLI used to stage RAG sources
+	CLIImageURL string
+	// RAGIndexerImageURL is the image indexing the documents of LightspeedRAGSources
+	RAGIndexerImageURL string
+	// EmbeddingModel is the embedding model the documents of LightspeedRAGSources are indexed with
+	EmbeddingModel string
+	VLLMImageURL   string
+	// RHOAIMCPServerImageURL is the image of the read-only RHOAI MCP server
+	RHOAIMCPServerImageURL string
+	MaxTokensForResponse   int
 }
 
 var OpenShiftAILightspeedDefaultValues OpenShiftAILightspeedDefaults
@@ -153,8 +923,43 @@ func SetupDefaults() {
 	openShiftAILightspeedDefaults := OpenShiftAILightspeedDefaults{
 		RAGImageURL: util.GetEnvVar(
 			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_IMAGE_URL_DEFAULT", OpenShiftAILightspeedContainerImage),
+		RAGImageURLs: GetRAGImageURLs(os.Environ()),
+		RAGBaseImageURL: util.GetEnvVar(
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RAG_BASE_IMAGE_URL_DEFAULT", OpenShiftAILightspeedRAGBaseImage),
+		CLIImageURL: util.GetEnvVar(
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_CLI_IMAGE_URL_DEFAULT", OpenShiftAILightspeedCLIImage),
+		RAGIndexerImageURL: util.GetEnvVar(
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RAG_INDEXER_IMAGE_URL_DEFAULT", OpenShiftAILightspeedRAGIndexerImage),
+		EmbeddingModel: util.GetEnvVar(
+			"OPENSHIFT_AI_LIGHTSPEED_EMBEDDING_MODEL_DEFAULT", EmbeddingModelDefault),
+		VLLMImageURL: util.GetEnvVar(
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_VLLM_IMAGE_URL_DEFAULT", OpenShiftAILightspeedVLLMImage),
+		RHOAIMCPServerImageURL: util.GetEnvVar(
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RHOAI_MCP_SERVER_IMAGE_URL_DEFAULT", OpenShiftAILightspeedRHOAIMCPServerImage),
 		MaxTokensForResponse: MaxTokensForResponseDefault,
 	}
 
 	OpenShiftAILightspeedDefaultValues = openShiftAILightspeedDefaults
 }
+
+// RAGImageVersionEnvPrefix - prefix of the environment variables that set the RAG image for an OpenShift AI
+// version, e.g. RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RAG_IMAGE_2_22 for OpenShift AI 2.22
+const RAGImageVersionEnvPrefix = "RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RAG_IMAGE_"
+
+// GetRAGImageURLs returns the OpenShift AI version to RAG image map set in the environment variables
+// prefixed with RAGImageVersionEnvPrefix. The environment is given in the os.Environ format.
+func GetRAGImageURLs(environ []string) map[string]string {
+	ragImageURLs := map[string]string{}
+
+	for _, env := range environ {
+		key, value, found := strings.Cut(env, "=")
+		if !found || value == "" || !strings.HasPrefix(key, RAGImageVersionEnvPrefix) {
+			continue
+		}
+
+		version := strings.ReplaceAll(strings.TrimPrefix(key, RAGImageVersionEnvPrefix), "_", ".")
+		ragImageURLs[version] = value
+	}
+
+	return ragImageURLs
+}
@@ -22,15 +22,692 @@ package v1beta1
 
 import (
 	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessSpec) DeepCopyInto(out *AccessSpec) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessSpec.
+func (in *AccessSpec) DeepCopy() *AccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessSubject) DeepCopyInto(out *AccessSubject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessSubject.
+func (in *AccessSubject) DeepCopy() *AccessSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalRAGStatus) DeepCopyInto(out *AdditionalRAGStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalRAGStatus.
+func (in *AdditionalRAGStatus) DeepCopy() *AdditionalRAGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalRAGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapDocumentSource) DeepCopyInto(out *ConfigMapDocumentSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapDocumentSource.
+func (in *ConfigMapDocumentSource) DeepCopy() *ConfigMapDocumentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapDocumentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConversationCacheSpec) DeepCopyInto(out *ConversationCacheSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationCacheSpec.
+func (in *ConversationCacheSpec) DeepCopy() *ConversationCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConversationCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitDocumentSource) DeepCopyInto(out *GitDocumentSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitDocumentSource.
+func (in *GitDocumentSource) DeepCopy() *GitDocumentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitDocumentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailsDetector) DeepCopyInto(out *GuardrailsDetector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailsDetector.
+func (in *GuardrailsDetector) DeepCopy() *GuardrailsDetector {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardrailsDetector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailsSpec) DeepCopyInto(out *GuardrailsSpec) {
+	*out = *in
+	if in.RegexDetectors != nil {
+		in, out := &in.RegexDetectors, &out.RegexDetectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Detectors != nil {
+		in, out := &in.Detectors, &out.Detectors
+		*out = make([]GuardrailsDetector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailsSpec.
+func (in *GuardrailsSpec) DeepCopy() *GuardrailsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardrailsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailsStatus) DeepCopyInto(out *GuardrailsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailsStatus.
+func (in *GuardrailsStatus) DeepCopy() *GuardrailsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardrailsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSpec) DeepCopyInto(out *HibernationSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSpec.
+func (in *HibernationSpec) DeepCopy() *HibernationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferenceServiceReference) DeepCopyInto(out *InferenceServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceServiceReference.
+func (in *InferenceServiceReference) DeepCopy() *InferenceServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(InferenceServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferenceServiceStatus) DeepCopyInto(out *InferenceServiceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceServiceStatus.
+func (in *InferenceServiceStatus) DeepCopy() *InferenceServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InferenceServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGContribution) DeepCopyInto(out *LightspeedRAGContribution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGContribution.
+func (in *LightspeedRAGContribution) DeepCopy() *LightspeedRAGContribution {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGContribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LightspeedRAGContribution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGContributionList) DeepCopyInto(out *LightspeedRAGContributionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LightspeedRAGContribution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGContributionList.
+func (in *LightspeedRAGContributionList) DeepCopy() *LightspeedRAGContributionList {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGContributionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LightspeedRAGContributionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGContributionSpec) DeepCopyInto(out *LightspeedRAGContributionSpec) {
+	*out = *in
+	if in.RAG != nil {
+		in, out := &in.RAG, &out.RAG
+		*out = make([]RAGContributionEntry, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGContributionSpec.
+func (in *LightspeedRAGContributionSpec) DeepCopy() *LightspeedRAGContributionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGContributionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGContributionStatus) DeepCopyInto(out *LightspeedRAGContributionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGContributionStatus.
+func (in *LightspeedRAGContributionStatus) DeepCopy() *LightspeedRAGContributionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGContributionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGSource) DeepCopyInto(out *LightspeedRAGSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGSource.
+func (in *LightspeedRAGSource) DeepCopy() *LightspeedRAGSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LightspeedRAGSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGSourceList) DeepCopyInto(out *LightspeedRAGSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LightspeedRAGSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGSourceList.
+func (in *LightspeedRAGSourceList) DeepCopy() *LightspeedRAGSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LightspeedRAGSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGSourceSpec) DeepCopyInto(out *LightspeedRAGSourceSpec) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitDocumentSource)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapDocumentSource)
+		**out = **in
+	}
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(PVCRAGSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGSourceSpec.
+func (in *LightspeedRAGSourceSpec) DeepCopy() *LightspeedRAGSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightspeedRAGSourceStatus) DeepCopyInto(out *LightspeedRAGSourceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IndexedAt != nil {
+		in, out := &in.IndexedAt, &out.IndexedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightspeedRAGSourceStatus.
+func (in *LightspeedRAGSourceStatus) DeepCopy() *LightspeedRAGSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LightspeedRAGSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LlamaStackDistributionReference) DeepCopyInto(out *LlamaStackDistributionReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackDistributionReference.
+func (in *LlamaStackDistributionReference) DeepCopy() *LlamaStackDistributionReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LlamaStackDistributionReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LlamaStackStatus) DeepCopyInto(out *LlamaStackStatus) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LlamaStackStatus.
+func (in *LlamaStackStatus) DeepCopy() *LlamaStackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LlamaStackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedModelSpec) DeepCopyInto(out *ManagedModelSpec) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedModelSpec.
+func (in *ManagedModelSpec) DeepCopy() *ManagedModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactRAGSource) DeepCopyInto(out *OCIArtifactRAGSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactRAGSource.
+func (in *OCIArtifactRAGSource) DeepCopy() *OCIArtifactRAGSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactRAGSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OLSOperatorSpec) DeepCopyInto(out *OLSOperatorSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OLSOperatorSpec.
+func (in *OLSOperatorSpec) DeepCopy() *OLSOperatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OLSOperatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OLSServerSpec) DeepCopyInto(out *OLSServerSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OLSServerSpec.
+func (in *OLSServerSpec) DeepCopy() *OLSServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OLSServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenShiftAILightspeed) DeepCopyInto(out *OpenShiftAILightspeed) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -55,6 +732,76 @@ func (in *OpenShiftAILightspeed) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenShiftAILightspeedCore) DeepCopyInto(out *OpenShiftAILightspeedCore) {
 	*out = *in
+	if in.InferenceServiceRef != nil {
+		in, out := &in.InferenceServiceRef, &out.InferenceServiceRef
+		*out = new(InferenceServiceReference)
+		**out = **in
+	}
+	if in.LlamaStackDistributionRef != nil {
+		in, out := &in.LlamaStackDistributionRef, &out.LlamaStackDistributionRef
+		*out = new(LlamaStackDistributionReference)
+		**out = **in
+	}
+	if in.ManagedModel != nil {
+		in, out := &in.ManagedModel, &out.ManagedModel
+		*out = new(ManagedModelSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LLMServiceAccountAuth != nil {
+		in, out := &in.LLMServiceAccountAuth, &out.LLMServiceAccountAuth
+		*out = new(ServiceAccountAuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(GuardrailsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemPrompt != nil {
+		in, out := &in.SystemPrompt, &out.SystemPrompt
+		*out = new(SystemPromptSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryFilters != nil {
+		in, out := &in.QueryFilters, &out.QueryFilters
+		*out = make([]QueryFilter, len(*in))
+		copy(*out, *in)
+	}
+	if in.OLSServer != nil {
+		in, out := &in.OLSServer, &out.OLSServer
+		*out = new(OLSServerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConversationCache != nil {
+		in, out := &in.ConversationCache, &out.ConversationCache
+		*out = new(ConversationCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(QuotasSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = new(ToolsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(AccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OLSOperator != nil {
+		in, out := &in.OLSOperator, &out.OLSOperator
+		*out = new(OLSOperatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftAILightspeedCore.
@@ -70,6 +817,13 @@ func (in *OpenShiftAILightspeedCore) DeepCopy() *OpenShiftAILightspeedCore {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenShiftAILightspeedDefaults) DeepCopyInto(out *OpenShiftAILightspeedDefaults) {
 	*out = *in
+	if in.RAGImageURLs != nil {
+		in, out := &in.RAGImageURLs, &out.RAGImageURLs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftAILightspeedDefaults.
@@ -117,7 +871,27 @@ func (in *OpenShiftAILightspeedList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenShiftAILightspeedSpec) DeepCopyInto(out *OpenShiftAILightspeedSpec) {
 	*out = *in
-	out.OpenShiftAILightspeedCore = in.OpenShiftAILightspeedCore
+	in.OpenShiftAILightspeedCore.DeepCopyInto(&out.OpenShiftAILightspeedCore)
+	if in.RAGSource != nil {
+		in, out := &in.RAGSource, &out.RAGSource
+		*out = new(RAGSourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAGContributions != nil {
+		in, out := &in.RAGContributions, &out.RAGContributions
+		*out = new(RAGContributionsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAGImagePullSecrets != nil {
+		in, out := &in.RAGImagePullSecrets, &out.RAGImagePullSecrets
+		*out = make([]SecretReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RAGImageUpdates != nil {
+		in, out := &in.RAGImageUpdates, &out.RAGImageUpdates
+		*out = new(RAGImageUpdatesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftAILightspeedSpec.
@@ -140,6 +914,60 @@ func (in *OpenShiftAILightspeedStatus) DeepCopyInto(out *OpenShiftAILightspeedSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InferenceService != nil {
+		in, out := &in.InferenceService, &out.InferenceService
+		*out = new(InferenceServiceStatus)
+		**out = **in
+	}
+	if in.LlamaStack != nil {
+		in, out := &in.LlamaStack, &out.LlamaStack
+		*out = new(LlamaStackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAGSource != nil {
+		in, out := &in.RAGSource, &out.RAGSource
+		*out = new(RAGSourceStatus)
+		**out = **in
+	}
+	if in.RAGImageUpdate != nil {
+		in, out := &in.RAGImageUpdate, &out.RAGImageUpdate
+		*out = new(RAGImageUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAG != nil {
+		in, out := &in.RAG, &out.RAG
+		*out = new(RAGStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RAGImages != nil {
+		in, out := &in.RAGImages, &out.RAGImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalRAG != nil {
+		in, out := &in.AdditionalRAG, &out.AdditionalRAG
+		*out = make([]AdditionalRAGStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(GuardrailsStatus)
+		**out = **in
+	}
+	if in.AccessSubjects != nil {
+		in, out := &in.AccessSubjects, &out.AccessSubjects
+		*out = make([]AccessSubject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyStatus)
+		**out = **in
+	}
+	if in.LLMClientCertificateNotAfter != nil {
+		in, out := &in.LLMClientCertificateNotAfter, &out.LLMClientCertificateNotAfter
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenShiftAILightspeedStatus.
@@ -151,3 +979,333 @@ func (in *OpenShiftAILightspeedStatus) DeepCopy() *OpenShiftAILightspeedStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVCRAGSource) DeepCopyInto(out *PVCRAGSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCRAGSource.
+func (in *PVCRAGSource) DeepCopy() *PVCRAGSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PVCRAGSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyStatus) DeepCopyInto(out *ProxyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatus.
+func (in *ProxyStatus) DeepCopy() *ProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryFilter) DeepCopyInto(out *QueryFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryFilter.
+func (in *QueryFilter) DeepCopy() *QueryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotasSpec) DeepCopyInto(out *QuotasSpec) {
+	*out = *in
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(TokenQuota)
+		**out = **in
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(TokenQuota)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotasSpec.
+func (in *QuotasSpec) DeepCopy() *QuotasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGContributionEntry) DeepCopyInto(out *RAGContributionEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGContributionEntry.
+func (in *RAGContributionEntry) DeepCopy() *RAGContributionEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGContributionEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGContributionsSpec) DeepCopyInto(out *RAGContributionsSpec) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGContributionsSpec.
+func (in *RAGContributionsSpec) DeepCopy() *RAGContributionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGContributionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGImageUpdateStatus) DeepCopyInto(out *RAGImageUpdateStatus) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGImageUpdateStatus.
+func (in *RAGImageUpdateStatus) DeepCopy() *RAGImageUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGImageUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGImageUpdatesSpec) DeepCopyInto(out *RAGImageUpdatesSpec) {
+	*out = *in
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGImageUpdatesSpec.
+func (in *RAGImageUpdatesSpec) DeepCopy() *RAGImageUpdatesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGImageUpdatesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGSourceSpec) DeepCopyInto(out *RAGSourceSpec) {
+	*out = *in
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(PVCRAGSource)
+		**out = **in
+	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactRAGSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGSourceSpec.
+func (in *RAGSourceSpec) DeepCopy() *RAGSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGSourceStatus) DeepCopyInto(out *RAGSourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGSourceStatus.
+func (in *RAGSourceStatus) DeepCopy() *RAGSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RAGStatus) DeepCopyInto(out *RAGStatus) {
+	*out = *in
+	if in.ResolvedAt != nil {
+		in, out := &in.ResolvedAt, &out.ResolvedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RAGStatus.
+func (in *RAGStatus) DeepCopy() *RAGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RAGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHOAIMCPServerSpec) DeepCopyInto(out *RHOAIMCPServerSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHOAIMCPServerSpec.
+func (in *RHOAIMCPServerSpec) DeepCopy() *RHOAIMCPServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RHOAIMCPServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAuthSpec) DeepCopyInto(out *ServiceAccountAuthSpec) {
+	*out = *in
+	if in.InferenceService != nil {
+		in, out := &in.InferenceService, &out.InferenceService
+		*out = new(InferenceServiceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAuthSpec.
+func (in *ServiceAccountAuthSpec) DeepCopy() *ServiceAccountAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemPromptSpec) DeepCopyInto(out *SystemPromptSpec) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemPromptSpec.
+func (in *SystemPromptSpec) DeepCopy() *SystemPromptSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemPromptSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenQuota) DeepCopyInto(out *TokenQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenQuota.
+func (in *TokenQuota) DeepCopy() *TokenQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolsSpec) DeepCopyInto(out *ToolsSpec) {
+	*out = *in
+	if in.RHOAIMCPServer != nil {
+		in, out := &in.RHOAIMCPServer, &out.RHOAIMCPServer
+		*out = new(RHOAIMCPServerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MCPServers != nil {
+		in, out := &in.MCPServers, &out.MCPServers
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolsSpec.
+func (in *ToolsSpec) DeepCopy() *ToolsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
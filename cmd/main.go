@@ -17,20 +17,30 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 
+	// Embed the time zone database, the maintenance windows can be scheduled in any IANA time zone
+	// and the operator image may not ship it.
+	_ "time/tzdata"
+
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -98,6 +108,17 @@ func main() {
 		tlsOpts = append(tlsOpts, disableHTTP2)
 	}
 
+	restConfig := ctrl.GetConfigOrDie()
+
+	// The metrics and webhook servers follow the TLS security profile of the cluster. They are restarted
+	// when it changes, see TLSProfileReconciler below.
+	tlsProfile, tlsProfileOpt, err := getClusterTLSProfile(restConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to get the TLS security profile of the cluster")
+		os.Exit(1)
+	}
+	tlsOpts = append(tlsOpts, tlsProfileOpt)
+
 	webhookServer := webhook.NewServer(webhook.Options{
 		TLSOpts: tlsOpts,
 	})
@@ -133,15 +154,23 @@ func main() {
 		os.Exit(1)
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	cacheByObject, err := getClusterWideCacheConfig(restConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to discover the APIs available in the cluster")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "c83b0a4f.lightspeed.openshift-ai.io",
+		NewClient:              controller.NewManagerClient,
 		Cache: cache.Options{
 			DefaultNamespaces: map[string]cache.Config{watchNamespace: {}},
+			ByObject:          cacheByObject,
 		},
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
@@ -164,12 +193,36 @@ func main() {
 	apiv1beta1.SetupDefaults()
 
 	if err = (&controller.OpenShiftAILightspeedReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		APIReader: mgr.GetAPIReader(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenShiftAILightspeed")
+		os.Exit(1)
+	}
+	if err = (&controller.LightspeedRAGSourceReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "OpenShiftAILightspeed")
+		setupLog.Error(err, "unable to create controller", "controller", "LightspeedRAGSource")
 		os.Exit(1)
 	}
+	// Stopping the manager shuts the servers down gracefully, they are started again with the new TLS
+	// security profile when the container is restarted
+	ctx, restart := context.WithCancelCause(ctrl.SetupSignalHandler())
+	defer restart(nil)
+	if controller.IsAPIAvailable(mgr.GetRESTMapper(), controller.APIServerGVK) {
+		if err = (&controller.TLSProfileReconciler{
+			Client:  mgr.GetClient(),
+			Profile: tlsProfile,
+			OnChange: func() {
+				restart(controller.ErrTLSProfileChanged)
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TLSProfile")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -182,10 +235,16 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	// Exiting with an error makes the container restart
+	if cause := context.Cause(ctx); errors.Is(cause, controller.ErrTLSProfileChanged) {
+		setupLog.Info("restarting the manager", "reason", cause.Error())
+		os.Exit(1)
+	}
 }
 
 // getWatchNamespace returns the Namespace the operator should be watching for changes
@@ -201,3 +260,66 @@ func getWatchNamespace() (string, error) {
 	}
 	return ns, nil
 }
+
+// getClusterTLSProfile returns the TLS security profile of the cluster along with the TLS option applying it to
+// the metrics and webhook servers.
+func getClusterTLSProfile(restConfig *rest.Config) (controller.TLSProfileSpec, func(*tls.Config), error) {
+	// The manager, and its cached client, is created with the TLS options
+	directClient, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return controller.TLSProfileSpec{}, nil, err
+	}
+
+	tlsProfile, err := controller.GetClusterTLSProfile(context.Background(), directClient)
+	if err != nil {
+		return controller.TLSProfileSpec{}, nil, err
+	}
+
+	tlsProfileOpt, unsupportedCiphers, err := controller.GetTLSConfigFunc(tlsProfile)
+	if err != nil {
+		return controller.TLSProfileSpec{}, nil, err
+	}
+	if len(unsupportedCiphers) > 0 {
+		setupLog.Info("TLS ciphers of the cluster TLS security profile not supported, they won't be used",
+			"ciphers", unsupportedCiphers)
+	}
+
+	return tlsProfile, tlsProfileOpt, nil
+}
+
+// getClusterWideCacheConfig returns the cache configuration for the objects that are referenced by
+// OpenShiftAILightspeed instances but may live outside of WATCH_NAMESPACE. Objects whose API is not
+// available in the cluster (e.g. KServe is not installed) are skipped, as caching them would prevent
+// the manager from starting.
+func getClusterWideCacheConfig(restConfig *rest.Config) (map[client.Object]cache.ByObject, error) {
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, err := apiutil.NewDynamicRESTMapper(restConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	byObject := map[client.Object]cache.ByObject{}
+	for _, gvk := range controller.ClusterWideWatchedGVKs {
+		if !controller.IsAPIAvailable(mapper, gvk) {
+			setupLog.Info("API not available in the cluster, it won't be watched", "GroupVersionKind", gvk)
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		byObject[obj] = cache.ByObject{
+			Namespaces: map[string]cache.Config{cache.AllNamespaces: {}},
+		}
+	}
+
+	// LightspeedRAGContributions are created by application teams in their own namespaces
+	byObject[&apiv1beta1.LightspeedRAGContribution{}] = cache.ByObject{
+		Namespaces: map[string]cache.Config{cache.AllNamespaces: {}},
+	}
+
+	return byObject, nil
+}
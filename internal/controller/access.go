@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for managing the users and groups allowed to query OLS.
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// OLSQueryClusterRole - ClusterRole created by the OLS operator that allows querying OLS
+	OLSQueryClusterRole = "lightspeed-operator-query-access"
+)
+
+// EnsureQueryAccess creates or updates the ClusterRoleBinding binding the users and groups of Access to the
+// OLS query ClusterRole, or removes it when none is set. The subjects bound to the ClusterRole by all the
+// ClusterRoleBindings, including the ones not managed by the operator, are listed in the status.
+func EnsureQueryAccess(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	// ClusterRoleBindings are cluster scoped
+	uncachedClient := GetUncachedClient(helper, reader)
+
+	var err error
+	subjects := GetQueryAccessSubjects(instance)
+	if len(subjects) == 0 {
+		err = RemoveQueryAccess(ctx, helper, reader, instance)
+	} else {
+		clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: GetQueryAccessName(instance),
+			},
+		}
+		_, err = controllerutil.CreateOrUpdate(ctx, uncachedClient, clusterRoleBinding, func() error {
+			clusterRoleBinding.SetLabels(map[string]string{
+				OpenShiftAILightspeedOwnerIDLabel: string(instance.GetUID()),
+			})
+			clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     OLSQueryClusterRole,
+			}
+			clusterRoleBinding.Subjects = subjects
+			return nil
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// The ClusterRoleBindings are listed from the cache, which is kept up to date by the watch on the
+	// ClusterRoleBindings of the OLS query ClusterRole
+	var clusterRoleBindings rbacv1.ClusterRoleBindingList
+	err = helper.GetClient().List(ctx, &clusterRoleBindings)
+	if err != nil {
+		return err
+	}
+
+	instance.Status.AccessSubjects = GetAccessSubjects(clusterRoleBindings.Items)
+	return nil
+}
+
+// GetQueryAccessSubjects returns the users and groups of Access as RBAC subjects.
+func GetQueryAccessSubjects(instance *apiv1beta1.OpenShiftAILightspeed) []rbacv1.Subject {
+	access := instance.Spec.Access
+	if access == nil {
+		return nil
+	}
+
+	subjects := make([]rbacv1.Subject, 0, len(access.Users)+len(access.Groups))
+	for _, user := range access.Users {
+		subjects = append(subjects, rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: user})
+	}
+	for _, group := range access.Groups {
+		subjects = append(subjects, rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: group})
+	}
+
+	return subjects
+}
+
+// GetAccessSubjects returns the subjects the ClusterRoleBindings bind to the OLS query ClusterRole, sorted by
+// kind and name.
+func GetAccessSubjects(clusterRoleBindings []rbacv1.ClusterRoleBinding) []apiv1beta1.AccessSubject {
+	var accessSubjects []apiv1beta1.AccessSubject
+
+	for _, clusterRoleBinding := range clusterRoleBindings {
+		if clusterRoleBinding.RoleRef.Kind != "ClusterRole" || clusterRoleBinding.RoleRef.Name != OLSQueryClusterRole {
+			continue
+		}
+
+		for _, subject := range clusterRoleBinding.Subjects {
+			name := subject.Name
+			if subject.Kind == rbacv1.ServiceAccountKind {
+				name = fmt.Sprintf("%s/%s", subject.Namespace, subject.Name)
+			}
+
+			accessSubjects = append(accessSubjects, apiv1beta1.AccessSubject{
+				Kind:               subject.Kind,
+				Name:               name,
+				ClusterRoleBinding: clusterRoleBinding.Name,
+			})
+		}
+	}
+
+	slices.SortFunc(accessSubjects, func(a, b apiv1beta1.AccessSubject) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.ClusterRoleBinding, b.ClusterRoleBinding),
+		)
+	})
+
+	return accessSubjects
+}
+
+// RemoveQueryAccess deletes the ClusterRoleBinding created by EnsureQueryAccess for the instance.
+func RemoveQueryAccess(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	return RemoveInstanceLabeledClusterObject(ctx, helper, reader, instance, &rbacv1.ClusterRoleBinding{},
+		GetQueryAccessName(instance))
+}
+
+// GetQueryAccessName returns the name of the ClusterRoleBinding of the users and groups allowed to query OLS.
+// The first 5 characters of the instance's UID are appended to avoid collisions between instances.
+func GetQueryAccessName(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	uid := string(instance.GetUID())
+	if len(uid) > 5 {
+		uid = uid[:5]
+	}
+	return fmt.Sprintf("openshift-ai-lightspeed-query-access-%s", uid)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Query access", func() {
+	It("should bind the allowed users and groups", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		Expect(GetQueryAccessSubjects(instance)).To(BeEmpty())
+
+		instance.Spec.Access = &apiv1beta1.AccessSpec{
+			Users:  []string{"alice"},
+			Groups: []string{"data-scientists"},
+		}
+		Expect(GetQueryAccessSubjects(instance)).To(Equal([]rbacv1.Subject{
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"},
+			{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "data-scientists"},
+		}))
+	})
+
+	It("should list the subjects bound to the OLS query ClusterRole", func() {
+		clusterRoleBindings := []rbacv1.ClusterRoleBinding{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "managed"},
+				RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: OLSQueryClusterRole},
+				Subjects: []rbacv1.Subject{
+					{Kind: rbacv1.UserKind, Name: "bob"},
+					{Kind: rbacv1.GroupKind, Name: "data-scientists"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "manual"},
+				RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: OLSQueryClusterRole},
+				Subjects: []rbacv1.Subject{
+					{Kind: rbacv1.ServiceAccountKind, Name: "bot", Namespace: "team-a"},
+					{Kind: rbacv1.UserKind, Name: "alice"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unrelated"},
+				RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.UserKind, Name: "carol"}},
+			},
+		}
+
+		Expect(GetAccessSubjects(clusterRoleBindings)).To(Equal([]apiv1beta1.AccessSubject{
+			{Kind: rbacv1.GroupKind, Name: "data-scientists", ClusterRoleBinding: "managed"},
+			{Kind: rbacv1.ServiceAccountKind, Name: "team-a/bot", ClusterRoleBinding: "manual"},
+			{Kind: rbacv1.UserKind, Name: "alice", ClusterRoleBinding: "manual"},
+			{Kind: rbacv1.UserKind, Name: "bob", ClusterRoleBinding: "managed"},
+		}))
+	})
+
+	It("should name the ClusterRoleBinding after the UID of the instance", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.UID = types.UID("3f2c9a1e-8d4b-4c6f-9e7a-1b2c3d4e5f60")
+		Expect(GetQueryAccessName(instance)).To(Equal("openshift-ai-lightspeed-query-access-3f2c9"))
+
+		instance.UID = types.UID("abc")
+		Expect(GetQueryAccessName(instance)).To(Equal("openshift-ai-lightspeed-query-access-abc"))
+	})
+
+	It("should bind the users and list the subjects of all the ClusterRoleBindings", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.Access = &apiv1beta1.AccessSpec{Users: []string{"alice"}}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "test-namespace"
+		instance.UID = types.UID("3f2c9a1e-8d4b-4c6f-9e7a-1b2c3d4e5f60")
+
+		manual := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "manual"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: OLSQueryClusterRole},
+			Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "sre"}},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1beta1.AddToScheme(scheme)).To(Succeed())
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(manual).Build()
+		helper, err := common_helper.NewHelper(instance, fakeClient, nil, scheme, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(EnsureQueryAccess(context.Background(), helper, fakeClient, instance)).To(Succeed())
+
+		clusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+		Expect(fakeClient.Get(context.Background(), client.ObjectKey{Name: GetQueryAccessName(instance)},
+			clusterRoleBinding)).To(Succeed())
+		Expect(clusterRoleBinding.GetLabels()).To(
+			HaveKeyWithValue(OpenShiftAILightspeedOwnerIDLabel, string(instance.GetUID())))
+		Expect(clusterRoleBinding.Subjects).To(Equal(GetQueryAccessSubjects(instance)))
+
+		Expect(instance.Status.AccessSubjects).To(Equal([]apiv1beta1.AccessSubject{
+			{Kind: rbacv1.GroupKind, Name: "sre", ClusterRoleBinding: "manual"},
+			{Kind: rbacv1.UserKind, Name: "alice", ClusterRoleBinding: GetQueryAccessName(instance)},
+		}))
+	})
+})
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the client certificate OLS presents to the LLM provider for mutual TLS.
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/blang/semver/v4"
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LLMClientCertificateExpiryWarning - how long ahead of its expiry the client certificate is reported as
+	// expiring
+	LLMClientCertificateExpiryWarning = 30 * 24 * time.Hour
+
+	// OLSClientCertificateMinVersion - minimum version of the OLS operator whose OLSConfig CRD has the
+	// tlsClientCertSecretRef of the LLM providers
+	OLSClientCertificateMinVersion = "1.0.6"
+)
+
+// GetClientCertificateUnsupportedReason returns why the installed OLS operator does not support presenting a
+// client certificate to the LLM provider, or an empty string when it does.
+func GetClientCertificateUnsupportedReason(ctx context.Context, helper *common_helper.Helper) (string, error) {
+	OLSOperatorCSV, err := GetOLSOperatorCSV(ctx, helper)
+	if err != nil {
+		return "", err
+	} else if OLSOperatorCSV == nil {
+		return "the OpenShift Lightspeed operator is not installed", nil
+	}
+
+	if !IsClientCertificateSupported(OLSOperatorCSV.Spec.Version.Version) {
+		return fmt.Sprintf("the OpenShift Lightspeed operator %s does not support it, %s or newer is required",
+			OLSOperatorCSV.Spec.Version.String(), OLSClientCertificateMinVersion), nil
+	}
+
+	return "", nil
+}
+
+// IsClientCertificateSupported returns true if the given version of the OLS operator supports presenting a
+// client certificate to the LLM provider. Pre-releases of the minimum version are accepted.
+func IsClientCertificateSupported(version semver.Version) bool {
+	minVersion := semver.MustParse(OLSClientCertificateMinVersion)
+	version.Pre = nil
+	version.Build = nil
+
+	return version.GTE(minVersion)
+}
+
+// GetLLMClientCertificate returns the client certificate stored in the LLMClientCertificate secret, or nil
+// when none is set. The returned error is an InvalidClientCertificateError when the secret exists but does
+// not hold a valid certificate and key pair.
+func GetLLMClientCertificate(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	now time.Time,
+) (*x509.Certificate, error) {
+	if instance.Spec.LLMClientCertificate == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      instance.Spec.LLMClientCertificate,
+		Namespace: instance.Namespace,
+	}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := ValidateClientCertificate(secret.Data, now)
+	if err != nil {
+		return nil, &InvalidClientCertificateError{
+			Err: fmt.Errorf("secret %s: %w", instance.Spec.LLMClientCertificate, err),
+		}
+	}
+
+	return certificate, nil
+}
+
+// InvalidClientCertificateError is returned when the client certificate secret does not hold a valid
+// certificate and key pair. Retrying won't help until the secret is fixed.
+type InvalidClientCertificateError struct {
+	Err error
+}
+
+func (e *InvalidClientCertificateError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *InvalidClientCertificateError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateClientCertificate checks that the data of a kubernetes.io/tls secret holds a certificate and its
+// matching private key, and that the certificate is valid at the given time. Returns the leaf certificate.
+func ValidateClientCertificate(data map[string][]byte, now time.Time) (*x509.Certificate, error) {
+	if len(data[corev1.TLSCertKey]) == 0 || len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil, fmt.Errorf("%s and %s must be set", corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+
+	keyPair, err := tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := keyPair.Leaf
+	if certificate == nil {
+		certificate, err = x509.ParseCertificate(keyPair.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if now.Before(certificate.NotBefore) {
+		return nil, fmt.Errorf("certificate is not valid before %s", certificate.NotBefore.UTC().Format(time.RFC3339))
+	}
+
+	if !now.Before(certificate.NotAfter) {
+		return nil, fmt.Errorf("certificate expired on %s", certificate.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	return certificate, nil
+}
+
+// IsClientCertificateExpiring returns true if the certificate expires within LLMClientCertificateExpiryWarning.
+func IsClientCertificateExpiring(certificate *x509.Certificate, now time.Time) bool {
+	return certificate.NotAfter.Sub(now) <= LLMClientCertificateExpiryWarning
+}
+
+// GetClientCertificateCheckDelay returns the delay after which the certificate must be checked again: when it
+// starts expiring, or when it expires once it is expiring.
+func GetClientCertificateCheckDelay(certificate *x509.Certificate, now time.Time) time.Duration {
+	if IsClientCertificateExpiring(certificate, now) {
+		return certificate.NotAfter.Sub(now)
+	}
+
+	return certificate.NotAfter.Add(-LLMClientCertificateExpiryWarning).Sub(now)
+}
+
+// IsReferencedLLMClientCertificate returns true if the instance presents the client certificate stored in the
+// secret with the given name and namespace to the LLM provider.
+func IsReferencedLLMClientCertificate(instance *apiv1beta1.OpenShiftAILightspeed, name string, namespace string) bool {
+	return instance.Spec.LLMClientCertificate != "" && instance.Spec.LLMClientCertificate == name &&
+		instance.Namespace == namespace
+}
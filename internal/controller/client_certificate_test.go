@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/blang/semver/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// newClientCertificate returns the PEM encoded self-signed certificate and private key valid in the given period
+func newClientCertificate(notBefore time.Time, notAfter time.Time) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "openshift-ai-lightspeed"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+var _ = Describe("LLM client certificate", func() {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	It("should accept a valid certificate and key pair", func() {
+		cert, key := newClientCertificate(now.Add(-time.Hour), now.Add(90*24*time.Hour))
+
+		certificate, err := ValidateClientCertificate(map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(certificate.Subject.CommonName).To(Equal("openshift-ai-lightspeed"))
+		Expect(IsClientCertificateExpiring(certificate, now)).To(BeFalse())
+		Expect(GetClientCertificateCheckDelay(certificate, now)).To(Equal(60 * 24 * time.Hour))
+	})
+
+	It("should reject invalid certificates", func() {
+		cert, key := newClientCertificate(now.Add(-time.Hour), now.Add(time.Hour))
+		_, otherKey := newClientCertificate(now.Add(-time.Hour), now.Add(time.Hour))
+
+		_, err := ValidateClientCertificate(map[string][]byte{corev1.TLSCertKey: cert}, now)
+		Expect(err).To(MatchError(ContainSubstring("tls.key")))
+
+		_, err = ValidateClientCertificate(map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: otherKey,
+		}, now)
+		Expect(err).To(HaveOccurred())
+
+		_, err = ValidateClientCertificate(map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		}, now.Add(2*time.Hour))
+		Expect(err).To(MatchError(ContainSubstring("expired")))
+
+		_, err = ValidateClientCertificate(map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		}, now.Add(-2*time.Hour))
+		Expect(err).To(MatchError(ContainSubstring("not valid before")))
+	})
+
+	It("should warn ahead of the expiry", func() {
+		cert, key := newClientCertificate(now.Add(-time.Hour), now.Add(10*24*time.Hour))
+
+		certificate, err := ValidateClientCertificate(map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsClientCertificateExpiring(certificate, now)).To(BeTrue())
+		Expect(GetClientCertificateCheckDelay(certificate, now)).To(Equal(10 * 24 * time.Hour))
+	})
+
+	It("should match the referenced secret", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Namespace = "openshift-lightspeed"
+		Expect(IsReferencedLLMClientCertificate(instance, "", "openshift-lightspeed")).To(BeFalse())
+
+		instance.Spec.LLMClientCertificate = "llm-client-cert"
+		Expect(IsReferencedLLMClientCertificate(instance, "llm-client-cert", "openshift-lightspeed")).To(BeTrue())
+		Expect(IsReferencedLLMClientCertificate(instance, "llm-client-cert", "other")).To(BeFalse())
+	})
+
+	It("should only support client certificates on recent OLS operators", func() {
+		Expect(IsClientCertificateSupported(semver.MustParse("1.0.5"))).To(BeFalse())
+		Expect(IsClientCertificateSupported(semver.MustParse(OLSClientCertificateMinVersion))).To(BeTrue())
+		Expect(IsClientCertificateSupported(semver.MustParse(OLSClientCertificateMinVersion + "-rc.1"))).To(BeTrue())
+		Expect(IsClientCertificateSupported(semver.MustParse("1.1.0"))).To(BeTrue())
+	})
+})
@@ -21,7 +21,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strconv"
+	"strings"
 
 	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,9 +31,11 @@ import (
 
 	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
@@ -44,12 +48,16 @@ const (
 	// that manages the OLSConfig.
 	OpenShiftAILightspeedOwnerIDLabel = "openshift-ai.io/lightspeed-owner-id"
 
+	// OpenShiftAILightspeedPausedAnnotation - annotation pausing the reconciliation of an
+	// OpenShiftAILightspeed instance when set to "true"
+	OpenShiftAILightspeedPausedAnnotation = "openshift-ai.io/lightspeed-paused"
+
 	// OpenShiftAILightspeedVectorDBPath - path inside of the container image where the vector DB are
-	// located
+	// located unless the RAG content discovery finds a different one
 	OpenShiftAILightspeedVectorDBPath = "/rag/vector_db/rhoai_product_docs"
 
-	// OpenShiftAILightspeedJobName - name of the pod that is used to discover environment variables inside of the RAG
-	// container image
+	// OpenShiftAILightspeedJobName - name of the Job that is used to discover the index metadata inside of the
+	// RAG container image
 	OpenShiftAILightspeedJobName = "openshift-ai-lightspeed"
 
 	// OLSConfigName - OLS forbids other name for OLSConfig instance than OLSConfigName
@@ -135,19 +143,19 @@ func PatchOLSConfig(
 	providersPatch := []interface{}{
 		map[string]interface{}{
 			"credentialsSecretRef": map[string]interface{}{
-				"name": instance.Spec.LLMCredentials,
+				"name": GetLLMCredentialsSecretName(instance),
 			},
 			"models": []interface{}{
 				map[string]interface{}{
-					"name": instance.Spec.ModelName,
+					"name": GetModelName(instance),
 					"parameters": map[string]interface{}{
 						"maxTokensForResponse": float64(instance.Spec.MaxTokensForResponse), // unstructured JSON numbers default to float64
 					},
 				},
 			},
 			"name": OpenShiftAILightspeedDefaultProvider,
-			"type": instance.Spec.LLMEndpointType,
-			"url":  instance.Spec.LLMEndpoint,
+			"type": GetLLMEndpointType(instance),
+			"url":  GetLLMEndpoint(instance),
 		},
 	}
 
@@ -170,34 +178,68 @@ func PatchOLSConfig(
 		}
 	}
 
+	if instance.Spec.LLMClientCertificate != "" {
+		err := uns.SetNestedField(provider, instance.Spec.LLMClientCertificate, "tlsClientCertSecretRef", "name")
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := uns.SetNestedSlice(olsConfig.Object, providersPatch, "spec", "llm", "providers"); err != nil {
 		return err
 	}
 
 	// Patch the RAG section
-	// NOTE(lucasagomes): We don't need indexID here because the tag on our RAG images
+	// NOTE(lucasagomes): When the index ID is not known, the tag on our RAG images
 	// already matches the indexID that the Vector DB used when it was built. OLS leverages
 	// that to set the right index.
-	rhoaiRAG := []interface{}{
-		map[string]interface{}{
-			"image":     instance.Spec.RAGImage,
-			"indexPath": OpenShiftAILightspeedVectorDBPath,
-		},
+	rag := map[string]interface{}{
+		"image":     GetRAGImage(instance),
+		"indexPath": GetRAGIndexPath(instance),
+	}
+	if indexID := GetRAGIndexID(instance); indexID != "" {
+		rag["indexID"] = indexID
+	}
+	rhoaiRAG := []interface{}{rag}
+
+	for _, additionalRAG := range instance.Status.AdditionalRAG {
+		rag := map[string]interface{}{
+			"image":     additionalRAG.Image,
+			"indexPath": additionalRAG.IndexPath,
+		}
+		if additionalRAG.IndexID != "" {
+			rag["indexID"] = additionalRAG.IndexID
+		}
+		rhoaiRAG = append(rhoaiRAG, rag)
 	}
 
 	if err := uns.SetNestedSlice(olsConfig.Object, rhoaiRAG, "spec", "ols", "rag"); err != nil {
 		return err
 	}
 
-	if instance.Spec.TLSCACertBundle != "" {
-		tlsCaCertBundle := instance.Spec.TLSCACertBundle
+	if pullSecrets := GetRAGImagePullSecrets(instance); len(pullSecrets) > 0 {
+		pullSecretsPatch := make([]interface{}, 0, len(pullSecrets))
+		for _, pullSecret := range pullSecrets {
+			pullSecretsPatch = append(pullSecretsPatch, map[string]interface{}{
+				"name": pullSecret.Name,
+			})
+		}
+
+		if err := uns.SetNestedSlice(olsConfig.Object, pullSecretsPatch, "spec", "ols", "imagePullSecrets"); err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "imagePullSecrets")
+	}
+
+	if tlsCaCertBundle := GetTLSCACertBundle(instance); tlsCaCertBundle != "" {
 		err := uns.SetNestedField(olsConfig.Object, tlsCaCertBundle, "spec", "ols", "additionalCAConfigMapRef", "name")
 		if err != nil {
 			return err
 		}
 	}
 
-	modelName := instance.Spec.ModelName
+	modelName := GetModelName(instance)
 	err := uns.SetNestedField(olsConfig.Object, modelName, "spec", "ols", "defaultModel")
 	if err != nil {
 		return err
@@ -228,6 +270,50 @@ func PatchOLSConfig(
 		return err
 	}
 
+	// The additional system prompt is resolved into Inline before the OLSConfig is patched
+	if instance.Spec.SystemPrompt != nil && instance.Spec.SystemPrompt.Inline != "" {
+		querySystemPrompt := GetQuerySystemPrompt(instance.Spec.SystemPrompt.Inline)
+		err = uns.SetNestedField(olsConfig.Object, querySystemPrompt, "spec", "ols", "querySystemPrompt")
+		if err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "querySystemPrompt")
+	}
+
+	if len(instance.Spec.QueryFilters) > 0 {
+		queryFiltersPatch := make([]interface{}, 0, len(instance.Spec.QueryFilters))
+		for _, queryFilter := range instance.Spec.QueryFilters {
+			queryFiltersPatch = append(queryFiltersPatch, map[string]interface{}{
+				"name":        queryFilter.Name,
+				"pattern":     queryFilter.Pattern,
+				"replaceWith": queryFilter.ReplaceWith,
+			})
+		}
+
+		err = uns.SetNestedSlice(olsConfig.Object, queryFiltersPatch, "spec", "ols", "queryFilters")
+		if err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "queryFilters")
+	}
+
+	err = PatchOLSDeployment(instance, olsConfig)
+	if err != nil {
+		return err
+	}
+
+	err = PatchOLSQuotas(instance, olsConfig)
+	if err != nil {
+		return err
+	}
+
+	err = PatchOLSTools(instance, olsConfig)
+	if err != nil {
+		return err
+	}
+
 	// Add info which OpenShiftAILightspeed instance owns the OLSConfig
 	labels := olsConfig.GetLabels()
 	updatedLabels := map[string]interface{}{
@@ -250,6 +336,228 @@ func PatchOLSConfig(
 	return nil
 }
 
+// ValidateOpenShiftAILightspeed validates the parts of the OpenShiftAILightspeed spec that cannot be
+// expressed via the CRD schema.
+func ValidateOpenShiftAILightspeed(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	if instance.Spec.RAGSource != nil {
+		if instance.Spec.RAGImage != "" {
+			return fmt.Errorf("ragSource cannot be combined with ragImage")
+		}
+
+		if err := ValidateRAGSource(instance.Spec.RAGSource); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateRAGContributions(instance); err != nil {
+		return err
+	}
+
+	if err := ValidateRAGImageUpdates(instance); err != nil {
+		return err
+	}
+
+	if err := ValidateQueryFilters(instance); err != nil {
+		return err
+	}
+
+	if err := ValidateQuotas(instance); err != nil {
+		return err
+	}
+
+	if err := ValidateTools(instance); err != nil {
+		return err
+	}
+
+	if err := ValidateHibernation(instance); err != nil {
+		return err
+	}
+
+	if instance.Spec.LLMClientCertificate != "" && instance.Spec.Guardrails != nil {
+		return fmt.Errorf("llmClientCertificate cannot be combined with guardrails")
+	}
+
+	if instance.Spec.LlamaStackDistributionRef != nil {
+		if instance.Spec.InferenceServiceRef != nil || instance.Spec.LLMEndpoint != "" || instance.Spec.ManagedModel != nil {
+			return fmt.Errorf("llamaStackDistributionRef cannot be combined with llmEndpoint, inferenceServiceRef or managedModel")
+		}
+	}
+
+	if instance.Spec.ManagedModel != nil {
+		if instance.Spec.InferenceServiceRef != nil || instance.Spec.LLMEndpoint != "" {
+			return fmt.Errorf("managedModel cannot be combined with llmEndpoint or inferenceServiceRef")
+		}
+
+		if instance.Spec.LLMEndpointType != "" && instance.Spec.LLMEndpointType != ManagedModelProviderType {
+			return fmt.Errorf("llmEndpointType must be %s when managedModel is set", ManagedModelProviderType)
+		}
+
+		return ValidateGuardrails(instance)
+	}
+
+	if instance.Spec.LLMEndpointType == "" && instance.Spec.LlamaStackDistributionRef == nil {
+		return fmt.Errorf("either llmEndpointType, managedModel or llamaStackDistributionRef must be set")
+	}
+
+	if instance.Spec.LLMCredentials == "" && instance.Spec.LLMServiceAccountAuth == nil {
+		return fmt.Errorf("either llmCredentials or llmServiceAccountAuth must be set")
+	}
+
+	if instance.Spec.InferenceServiceRef == nil && instance.Spec.LlamaStackDistributionRef == nil {
+		if instance.Spec.LLMEndpoint == "" {
+			return fmt.Errorf("either llmEndpoint or inferenceServiceRef must be set")
+		}
+
+		if instance.Spec.ModelName == "" {
+			return fmt.Errorf("either modelName or inferenceServiceRef must be set")
+		}
+	}
+
+	if instance.Spec.LLMServiceAccountAuth != nil && GetServiceAccountAuthInferenceService(instance) == nil {
+		return fmt.Errorf("llmServiceAccountAuth.inferenceService must be set when inferenceServiceRef is not set")
+	}
+
+	return ValidateGuardrails(instance)
+}
+
+// ValidateGuardrails returns an error if the detectors listed in Guardrails cannot be configured.
+func ValidateGuardrails(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	if instance.Spec.Guardrails == nil {
+		return nil
+	}
+
+	for _, detector := range instance.Spec.Guardrails.Detectors {
+		if detector.Name == guardrailsRegexDetector {
+			return fmt.Errorf("guardrails detector name %s is reserved for the built-in regex detector",
+				guardrailsRegexDetector)
+		}
+
+		if _, err := GetGuardrailsService(detector.URL); err != nil {
+			return fmt.Errorf("guardrails detector %s has an invalid url: %w", detector.Name, err)
+		}
+
+		if detector.Threshold != "" {
+			threshold, err := strconv.ParseFloat(detector.Threshold, 64)
+			if err != nil || threshold < 0 || threshold > 1 {
+				return fmt.Errorf("guardrails detector %s threshold must be a number between 0 and 1", detector.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLLMEndpoint returns the URL OLS sends the LLM requests to. When Guardrails is set it is the URL of
+// the gateway of the GuardrailsOrchestrator, otherwise it is the URL returned by GetUpstreamLLMEndpoint.
+func GetLLMEndpoint(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.Guardrails != nil && instance.Status.Guardrails != nil {
+		return instance.Status.Guardrails.GatewayURL
+	}
+
+	return GetUpstreamLLMEndpoint(instance)
+}
+
+// GetUpstreamLLMEndpoint returns the URL of the LLM. When InferenceServiceRef or ManagedModel is set it
+// is the URL resolved from the InferenceService, when LlamaStackDistributionRef is set it is the URL
+// resolved from the LlamaStackDistribution.
+func GetUpstreamLLMEndpoint(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if GetInferenceServiceRef(instance) != nil && instance.Status.InferenceService != nil {
+		return instance.Status.InferenceService.URL
+	}
+
+	if instance.Spec.LlamaStackDistributionRef != nil && instance.Status.LlamaStack != nil {
+		return instance.Status.LlamaStack.URL
+	}
+
+	return instance.Spec.LLMEndpoint
+}
+
+// GetLLMEndpointType returns the type of the provider serving the LLM, which is always
+// ManagedModelProviderType for the managed model and defaults to LlamaStackProviderType for
+// LlamaStackDistributions.
+func GetLLMEndpointType(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.ManagedModel != nil {
+		return ManagedModelProviderType
+	}
+
+	if instance.Spec.LlamaStackDistributionRef != nil && instance.Spec.LLMEndpointType == "" {
+		return LlamaStackProviderType
+	}
+
+	return instance.Spec.LLMEndpointType
+}
+
+// GetModelName returns the name of the model to use. A model name set in the spec takes precedence
+// over the one resolved from the InferenceService or the first model of the LlamaStackDistribution.
+func GetModelName(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.ModelName != "" {
+		return instance.Spec.ModelName
+	}
+
+	if instance.Status.InferenceService != nil {
+		return instance.Status.InferenceService.ModelName
+	}
+
+	if instance.Status.LlamaStack != nil && len(instance.Status.LlamaStack.Models) > 0 {
+		return instance.Status.LlamaStack.Models[0]
+	}
+
+	return ""
+}
+
+// GetTLSCACertBundle returns the name of the ConfigMap containing the CA bundle OLS is configured with. When
+// the cluster-wide proxy is adopted it is the bundle merging the trusted CA bundle of the cluster with the
+// CA bundle for the LLM.
+func GetTLSCACertBundle(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Status.Proxy != nil && instance.Status.Proxy.CACertBundle != "" {
+		return instance.Status.Proxy.CACertBundle
+	}
+
+	return GetLLMTLSCACertBundle(instance)
+}
+
+// GetLLMTLSCACertBundle returns the name of the ConfigMap containing the CA bundle for the LLM OLS sends the
+// requests to: the gateway of the GuardrailsOrchestrator when Guardrails is set, otherwise the LLM returned by
+// GetUpstreamLLMEndpoint.
+func GetLLMTLSCACertBundle(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	// The gateway of the GuardrailsOrchestrator uses a certificate signed by the service CA
+	if instance.Spec.Guardrails != nil && instance.Status.Guardrails != nil {
+		return ServiceCACertBundle
+	}
+
+	return GetUpstreamLLMTLSCACertBundle(instance)
+}
+
+// GetUpstreamLLMTLSCACertBundle returns the name of the ConfigMap containing the CA bundle for the LLM returned
+// by GetUpstreamLLMEndpoint. A bundle set in the spec takes precedence over the one resolved from the
+// InferenceService.
+func GetUpstreamLLMTLSCACertBundle(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.TLSCACertBundle != "" {
+		return instance.Spec.TLSCACertBundle
+	}
+
+	if GetInferenceServiceRef(instance) != nil && instance.Status.InferenceService != nil {
+		return instance.Status.InferenceService.CACertBundle
+	}
+
+	return ""
+}
+
+// IsPaused returns true if the reconciliation of the instance is paused with Paused or the paused annotation.
+func IsPaused(instance *apiv1beta1.OpenShiftAILightspeed) bool {
+	return instance.Spec.Paused || instance.GetAnnotations()[OpenShiftAILightspeedPausedAnnotation] == "true"
+}
+
+// GetLLMCredentialsSecretName returns the name of the secret OLS reads the LLM API token from. When the
+// InferenceService is accessed with the operator managed ServiceAccount it is the secret holding its token.
+func GetLLMCredentialsSecretName(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if GetServiceAccountAuthInferenceService(instance) != nil {
+		return OpenShiftAILightspeedTokenSecretName
+	}
+
+	return instance.Spec.LLMCredentials
+}
+
 // IsOLSConfigReady returns true if required conditions are true for OLSConfig
 func IsOLSConfigReady(ctx context.Context, helper *common_helper.Helper) (bool, error) {
 	olsConfig, err := GetOLSConfig(ctx, helper)
@@ -285,6 +593,110 @@ func IsOLSConfigReady(ctx context.Context, helper *common_helper.Helper) (bool,
 	return true, nil
 }
 
+// IsObjectReady returns true if the Ready condition of the given object is True. When it is not, the
+// message of the condition is returned.
+func IsObjectReady(obj *uns.Unstructured) (bool, string, error) {
+	conditions, _, err := uns.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, c := range conditions {
+		objCondition, ok := c.(map[string]interface{})
+		if !ok || objCondition["type"] != "Ready" {
+			continue
+		}
+
+		if objCondition["status"] == string(metav1.ConditionTrue) {
+			return true, "", nil
+		}
+
+		message, _ := objCondition["message"].(string)
+		return false, message, nil
+	}
+
+	return false, fmt.Sprintf("%s has no Ready condition yet", obj.GetKind()), nil
+}
+
+// RemoveInstanceOwnedObject deletes the object of the given kind and name in the namespace of the instance
+// if it exists and it is owned by the instance. Objects whose API is not available in the cluster are
+// considered removed. Returns true once the object is gone.
+func RemoveInstanceOwnedObject(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	gvk schema.GroupVersionKind,
+	name string,
+) (bool, error) {
+	obj := &uns.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      name,
+		Namespace: instance.Namespace,
+	}, obj)
+	if err != nil && (k8s_errors.IsNotFound(err) || meta.IsNoMatchError(err)) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if !IsOwnedBy(obj, instance) {
+		return true, nil
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return false, nil
+	}
+
+	err = helper.GetClient().Delete(ctx, obj)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// RemoveInstanceLabeledClusterObject deletes the cluster scoped object with the given name if it exists and
+// it is labeled with the UID of the instance. Cluster scoped objects cannot be owned by the instance, so they
+// are labeled with OpenShiftAILightspeedOwnerIDLabel instead. The object's kind is taken from obj.
+func RemoveInstanceLabeledClusterObject(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	obj client.Object,
+	name string,
+) error {
+	err := reader.Get(ctx, client.ObjectKey{Name: name}, obj)
+	if err != nil && k8s_errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if obj.GetLabels()[OpenShiftAILightspeedOwnerIDLabel] != string(instance.GetUID()) {
+		return nil
+	}
+
+	err = helper.GetClient().Delete(ctx, obj)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// JobFailedError is returned when a Job has failed. The Job is kept, so that the logs of its pods can be
+// inspected, and it is not retried until its input changes.
+type JobFailedError struct {
+	Job     string
+	Message string
+}
+
+func (e *JobFailedError) Error() string {
+	return fmt.Sprintf("job %s failed: %s", e.Job, e.Message)
+}
+
 // IsOwnedBy returns true if 'object' is owned by 'owner' based on OwnerReference UID.
 func IsOwnedBy(object metav1.Object, owner metav1.Object) bool {
 	for _, ref := range object.GetOwnerReferences() {
@@ -312,6 +724,85 @@ func GetRawClient(helper *common_helper.Helper) (client.Client, error) {
 	return rawClient, nil
 }
 
+// uncachedClient reads objects with an uncached reader and writes them with the client it embeds.
+type uncachedClient struct {
+	client.Client
+	reader client.Reader
+}
+
+// Get retrieves an obj for the given object key from the API server.
+func (c *uncachedClient) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	return c.reader.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves the list of objects for the given options from the API server.
+func (c *uncachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// GetUncachedClient returns a client that reads objects with reader, which is not restricted to WATCH_NAMESPACE,
+// and writes them with the client of the helper. Unlike GetRawClient, no new client is created, so reader is
+// meant to be the API reader of the manager, e.g. to create or update objects outside of WATCH_NAMESPACE.
+func GetUncachedClient(helper *common_helper.Helper, reader client.Reader) client.Client {
+	return &uncachedClient{Client: helper.GetClient(), reader: reader}
+}
+
+// clusterWideCachedClient reads the unstructured objects of ClusterWideWatchedGVKs from the cache instead of the
+// API server.
+type clusterWideCachedClient struct {
+	client.Client
+	cache client.Reader
+}
+
+// isClusterWideCached returns true if the objects of the given GroupVersionKind are cached in all namespaces.
+func isClusterWideCached(gvk schema.GroupVersionKind) bool {
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	return slices.Contains(ClusterWideWatchedGVKs, gvk) || slices.Contains(ClusterScopedWatchedGVKs, gvk)
+}
+
+// Get retrieves an obj for the given object key, from the cache for the objects of ClusterWideWatchedGVKs and
+// ClusterScopedWatchedGVKs.
+func (c *clusterWideCachedClient) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	if _, isUnstructured := obj.(*uns.Unstructured); isUnstructured &&
+		isClusterWideCached(obj.GetObjectKind().GroupVersionKind()) {
+		return c.cache.Get(ctx, key, obj, opts...)
+	}
+
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves a list of objects, from the cache for the objects of ClusterWideWatchedGVKs and
+// ClusterScopedWatchedGVKs.
+func (c *clusterWideCachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, isUnstructured := list.(*uns.UnstructuredList); isUnstructured &&
+		isClusterWideCached(list.GetObjectKind().GroupVersionKind()) {
+		return c.cache.List(ctx, list, opts...)
+	}
+
+	return c.Client.List(ctx, list, opts...)
+}
+
+// NewManagerClient creates the client of the manager. Unstructured objects are read from the API server, except
+// the ones of ClusterWideWatchedGVKs and ClusterScopedWatchedGVKs, which are watched and cached in all namespaces.
+func NewManagerClient(config *rest.Config, options client.Options) (client.Client, error) {
+	c, err := client.New(config, options)
+	if err != nil || options.Cache == nil || options.Cache.Reader == nil {
+		return c, err
+	}
+
+	return &clusterWideCachedClient{Client: c, cache: options.Cache.Reader}, nil
+}
+
 // OLSConfigPing adds a random label to the OLSConfig to trigger a reconciliation
 // by the OpenShift Lightspeed operator. This causes the operator to update the Status field.
 // Note: This is a workaround for a current limitation—when the OLS operator is installed
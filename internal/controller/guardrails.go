@@ -0,0 +1,473 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for sending the LLM requests through a TrustyAI GuardrailsOrchestrator.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// OpenShiftAILightspeedGuardrailsName - name of the GuardrailsOrchestrator managed by the operator
+	OpenShiftAILightspeedGuardrailsName = "openshift-ai-lightspeed-guardrails"
+
+	// OpenShiftAILightspeedGuardrailsConfigName - name of the ConfigMap with the orchestrator configuration
+	OpenShiftAILightspeedGuardrailsConfigName = OpenShiftAILightspeedGuardrailsName + "-config"
+
+	// OpenShiftAILightspeedGuardrailsGatewayConfigName - name of the ConfigMap with the gateway configuration
+	OpenShiftAILightspeedGuardrailsGatewayConfigName = OpenShiftAILightspeedGuardrailsName + "-gateway-config"
+
+	// OpenShiftAILightspeedGuardrailsLLMCAName - name of the secret with the CA bundle for the LLM mounted
+	// into the operator managed GuardrailsOrchestrator
+	OpenShiftAILightspeedGuardrailsLLMCAName = OpenShiftAILightspeedGuardrailsName + "-llm-ca"
+
+	// GuardrailsConfigKey - key in the configuration ConfigMaps read by the GuardrailsOrchestrator
+	GuardrailsConfigKey = "config.yaml"
+
+	// guardrailsLLMCACertKey - key of the CA bundle in the OpenShiftAILightspeedGuardrailsLLMCAName secret
+	guardrailsLLMCACertKey = "ca.crt"
+
+	// guardrailsTLSMountPath - directory the TrustyAI operator mounts the secrets listed in the tlsSecrets of
+	// a GuardrailsOrchestrator into, each in a subdirectory named after the secret
+	guardrailsTLSMountPath = "/etc/tls"
+
+	// guardrailsRegexDetector - name of the built-in regex detector of the GuardrailsOrchestrator
+	guardrailsRegexDetector = "regex"
+
+	// guardrailsBuiltInDetectorsPort - port the built-in detectors listen on inside of the orchestrator pod
+	guardrailsBuiltInDetectorsPort = 8080
+
+	// guardrailsOrchestratorPort - port the orchestrator listens on inside of the orchestrator pod
+	guardrailsOrchestratorPort = 8032
+
+	// guardrailsGatewayPort - port of the gateway exposed by the service of the GuardrailsOrchestrator
+	guardrailsGatewayPort = 8090
+
+	// guardrailsDefaultThreshold - detection threshold used when a detector doesn't set one
+	guardrailsDefaultThreshold = 0.5
+)
+
+// GuardrailsOrchestratorGVK - GroupVersionKind of TrustyAI GuardrailsOrchestrators
+var GuardrailsOrchestratorGVK = schema.GroupVersionKind{
+	Group:   "trustyai.opendatahub.io",
+	Version: "v1alpha1",
+	Kind:    "GuardrailsOrchestrator",
+}
+
+// EnsureGuardrails makes sure that the GuardrailsOrchestrator the LLM requests are sent through exists and
+// stores its gateway URL in the instance status. The operator managed GuardrailsOrchestrator is created
+// unless Guardrails.ExistingOrchestrator is set. Returns true if the GuardrailsOrchestrator is ready, and a
+// message describing why it is not ready otherwise.
+func EnsureGuardrails(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, string, error) {
+	if instance.Spec.Guardrails.ExistingOrchestrator == "" {
+		err := EnsureGuardrailsOrchestrator(ctx, helper, instance)
+		if err != nil {
+			return false, "", err
+		}
+	} else {
+		// The operator managed orchestrator is not needed anymore
+		_, err := RemoveGuardrailsOrchestrator(ctx, helper, instance)
+		if err != nil {
+			return false, "", err
+		}
+	}
+
+	orchestrator := &uns.Unstructured{}
+	orchestrator.SetGroupVersionKind(GuardrailsOrchestratorGVK)
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      GetGuardrailsOrchestratorName(instance),
+		Namespace: instance.Namespace,
+	}, orchestrator)
+	if err != nil {
+		return false, "", err
+	}
+
+	gatewayEnabled, _, err := uns.NestedBool(orchestrator.Object, "spec", "enableGuardrailsGateway")
+	if err != nil {
+		return false, "", err
+	}
+	if !gatewayEnabled {
+		return false, "", fmt.Errorf("GuardrailsOrchestrator %s has the guardrails gateway disabled",
+			orchestrator.GetName())
+	}
+
+	ready, message, err := IsObjectReady(orchestrator)
+	if err != nil || !ready {
+		return false, message, err
+	}
+
+	instance.Status.Guardrails = &apiv1beta1.GuardrailsStatus{
+		Orchestrator: orchestrator.GetName(),
+		GatewayURL:   GetGuardrailsGatewayURL(instance, orchestrator.GetName()),
+	}
+
+	return true, "", nil
+}
+
+// EnsureGuardrailsOrchestrator creates or updates the GuardrailsOrchestrator managed by the operator and
+// its configuration. The orchestrator forwards the requests to the LLM returned by GetUpstreamLLMEndpoint
+// and applies the built-in regex detector and the detectors listed in Guardrails.Detectors on the
+// gateway route OLS sends the requests to.
+func EnsureGuardrailsOrchestrator(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	orchestratorConfig, err := GetGuardrailsOrchestratorConfig(instance)
+	if err != nil {
+		return err
+	}
+
+	gatewayConfig, err := GetGuardrailsGatewayConfig(instance)
+	if err != nil {
+		return err
+	}
+
+	configMaps := map[string]string{
+		OpenShiftAILightspeedGuardrailsConfigName:        orchestratorConfig,
+		OpenShiftAILightspeedGuardrailsGatewayConfigName: gatewayConfig,
+	}
+	for name, config := range configMaps {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: instance.Namespace,
+			},
+		}
+		_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), configMap, func() error {
+			configMap.Data = map[string]string{
+				GuardrailsConfigKey: config,
+			}
+			return controllerutil.SetControllerReference(instance, configMap, helper.GetScheme())
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	hasLLMCACertBundle, err := ensureGuardrailsLLMCACertBundle(ctx, helper, instance)
+	if err != nil {
+		return err
+	}
+
+	orchestrator := &uns.Unstructured{}
+	orchestrator.SetGroupVersionKind(GuardrailsOrchestratorGVK)
+	orchestrator.SetName(OpenShiftAILightspeedGuardrailsName)
+	orchestrator.SetNamespace(instance.Namespace)
+
+	_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), orchestrator, func() error {
+		orchestratorSpec := map[string]interface{}{
+			"replicas":                int64(1),
+			"orchestratorConfig":      OpenShiftAILightspeedGuardrailsConfigName,
+			"enableBuiltInDetectors":  true,
+			"enableGuardrailsGateway": true,
+			"guardrailsGatewayConfig": OpenShiftAILightspeedGuardrailsGatewayConfigName,
+		}
+		if hasLLMCACertBundle {
+			orchestratorSpec["tlsSecrets"] = []interface{}{OpenShiftAILightspeedGuardrailsLLMCAName}
+		}
+
+		if err := uns.SetNestedMap(orchestrator.Object, orchestratorSpec, "spec"); err != nil {
+			return err
+		}
+
+		return controllerutil.SetControllerReference(instance, orchestrator, helper.GetScheme())
+	})
+
+	return err
+}
+
+// ensureGuardrailsLLMCACertBundle copies the CA bundle returned by GetUpstreamLLMTLSCACertBundle into the
+// OpenShiftAILightspeedGuardrailsLLMCAName secret mounted into the operator managed GuardrailsOrchestrator, or
+// removes the secret when no CA bundle is set. Returns true if the secret exists.
+func ensureGuardrailsLLMCACertBundle(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, error) {
+	caCertBundleName := GetUpstreamLLMTLSCACertBundle(instance)
+	if caCertBundleName == "" {
+		_, err := RemoveInstanceOwnedObject(ctx, helper, instance, corev1.SchemeGroupVersion.WithKind("Secret"),
+			OpenShiftAILightspeedGuardrailsLLMCAName)
+		return false, err
+	}
+
+	caCertBundle := &corev1.ConfigMap{}
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      caCertBundleName,
+		Namespace: instance.Namespace,
+	}, caCertBundle)
+	if err != nil {
+		return false, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedGuardrailsLLMCAName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), secret, func() error {
+		secret.Data = map[string][]byte{
+			guardrailsLLMCACertKey: []byte(MergeCACertBundles("", caCertBundle.Data)),
+		}
+		return controllerutil.SetControllerReference(instance, secret, helper.GetScheme())
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetGuardrailsOrchestratorConfig returns the configuration of the operator managed GuardrailsOrchestrator.
+// The Authorization header sent by OLS is passed through to the LLM, whose certificate is verified with the
+// CA bundle returned by GetUpstreamLLMTLSCACertBundle when one is set.
+func GetGuardrailsOrchestratorConfig(instance *apiv1beta1.OpenShiftAILightspeed) (string, error) {
+	llmService, err := GetGuardrailsService(GetUpstreamLLMEndpoint(instance))
+	if err != nil {
+		return "", err
+	}
+
+	detectors := map[string]interface{}{
+		guardrailsRegexDetector: map[string]interface{}{
+			"type": "text_contents",
+			"service": map[string]interface{}{
+				"hostname": "127.0.0.1",
+				"port":     guardrailsBuiltInDetectorsPort,
+			},
+			"chunker_id":        "whole_doc_chunker",
+			"default_threshold": guardrailsDefaultThreshold,
+		},
+	}
+
+	for _, detector := range instance.Spec.Guardrails.Detectors {
+		detectorService, err := GetGuardrailsService(detector.URL)
+		if err != nil {
+			return "", err
+		}
+
+		threshold := guardrailsDefaultThreshold
+		if detector.Threshold != "" {
+			threshold, err = strconv.ParseFloat(detector.Threshold, 64)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		detectors[detector.Name] = map[string]interface{}{
+			"type":              "text_contents",
+			"service":           detectorService,
+			"chunker_id":        "whole_doc_chunker",
+			"default_threshold": threshold,
+		}
+	}
+
+	config := map[string]interface{}{
+		"chat_generation": map[string]interface{}{
+			"service": llmService,
+		},
+		"detectors":           detectors,
+		"passthrough_headers": []string{"authorization"},
+	}
+
+	if _, ok := llmService["tls"]; ok {
+		llmTLS := map[string]interface{}{
+			"insecure": false,
+		}
+		if GetUpstreamLLMTLSCACertBundle(instance) != "" {
+			llmTLS["client_ca_cert_path"] = path.Join(guardrailsTLSMountPath, OpenShiftAILightspeedGuardrailsLLMCAName,
+				guardrailsLLMCACertKey)
+		}
+		config["tls"] = map[string]interface{}{
+			"llm": llmTLS,
+		}
+	}
+
+	configYAML, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(configYAML), nil
+}
+
+// GetGuardrailsGatewayConfig returns the configuration of the gateway of the operator managed
+// GuardrailsOrchestrator. All detectors are applied on both the input and the output of the route
+// OLS sends the requests to.
+func GetGuardrailsGatewayConfig(instance *apiv1beta1.OpenShiftAILightspeed) (string, error) {
+	regexDetectors := instance.Spec.Guardrails.RegexDetectors
+	if len(regexDetectors) == 0 {
+		regexDetectors = []string{"email", "ssn", "credit-card"}
+	}
+
+	detectors := []interface{}{
+		map[string]interface{}{
+			"name":   guardrailsRegexDetector,
+			"input":  true,
+			"output": true,
+			"detector_params": map[string]interface{}{
+				"regex": regexDetectors,
+			},
+		},
+	}
+	detectorNames := []string{guardrailsRegexDetector}
+
+	for _, detector := range instance.Spec.Guardrails.Detectors {
+		detectors = append(detectors, map[string]interface{}{
+			"name":   detector.Name,
+			"input":  true,
+			"output": true,
+		})
+		detectorNames = append(detectorNames, detector.Name)
+	}
+
+	config := map[string]interface{}{
+		"orchestrator": map[string]interface{}{
+			"host": "localhost",
+			"port": guardrailsOrchestratorPort,
+		},
+		"detectors": detectors,
+		"routes": []interface{}{
+			map[string]interface{}{
+				"name":      GetGuardrailsRoute(instance),
+				"detectors": detectorNames,
+			},
+		},
+	}
+
+	configYAML, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(configYAML), nil
+}
+
+// GetGuardrailsService returns the service section of the orchestrator configuration for the given URL.
+// The port defaults to the default port of the URL scheme.
+func GetGuardrailsService(rawURL string) (map[string]interface{}, error) {
+	serviceURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if serviceURL.Hostname() == "" {
+		return nil, fmt.Errorf("URL %q has no host", rawURL)
+	}
+
+	port := 80
+	if serviceURL.Scheme == "https" {
+		port = 443
+	}
+	if serviceURL.Port() != "" {
+		port, err = strconv.Atoi(serviceURL.Port())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	service := map[string]interface{}{
+		"hostname": serviceURL.Hostname(),
+		"port":     port,
+	}
+	if serviceURL.Scheme == "https" {
+		service["tls"] = "llm"
+	}
+
+	return service, nil
+}
+
+// RemoveGuardrailsOrchestrator deletes the GuardrailsOrchestrator managed by the operator and its
+// configuration if they exist and are owned by the instance. Returns true once all of them are gone.
+func RemoveGuardrailsOrchestrator(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, error) {
+	configMapGVK := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+
+	objects := []struct {
+		gvk  schema.GroupVersionKind
+		name string
+	}{
+		{GuardrailsOrchestratorGVK, OpenShiftAILightspeedGuardrailsName},
+		{configMapGVK, OpenShiftAILightspeedGuardrailsConfigName},
+		{configMapGVK, OpenShiftAILightspeedGuardrailsGatewayConfigName},
+		{corev1.SchemeGroupVersion.WithKind("Secret"), OpenShiftAILightspeedGuardrailsLLMCAName},
+	}
+
+	isRemoved := true
+	for _, object := range objects {
+		isObjectRemoved, err := RemoveInstanceOwnedObject(ctx, helper, instance, object.gvk, object.name)
+		if err != nil {
+			return false, err
+		}
+		isRemoved = isRemoved && isObjectRemoved
+	}
+
+	return isRemoved, nil
+}
+
+// GetGuardrailsOrchestratorName returns the name of the GuardrailsOrchestrator the LLM requests are sent
+// through or an empty string when Guardrails is not set.
+func GetGuardrailsOrchestratorName(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.Guardrails == nil {
+		return ""
+	}
+
+	if instance.Spec.Guardrails.ExistingOrchestrator != "" {
+		return instance.Spec.Guardrails.ExistingOrchestrator
+	}
+
+	return OpenShiftAILightspeedGuardrailsName
+}
+
+// GetGuardrailsRoute returns the name of the gateway route OLS sends the requests to.
+func GetGuardrailsRoute(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.Guardrails.Route != "" {
+		return instance.Spec.Guardrails.Route
+	}
+
+	return "lightspeed"
+}
+
+// GetGuardrailsGatewayURL returns the URL of the OpenAI compatible API of the gateway route of the given
+// GuardrailsOrchestrator. The gateway is exposed by the orchestrator service with a certificate signed
+// by the service CA.
+func GetGuardrailsGatewayURL(instance *apiv1beta1.OpenShiftAILightspeed, orchestratorName string) string {
+	return fmt.Sprintf("https://%s-service.%s.svc:%d/%s%s",
+		orchestratorName, instance.Namespace, guardrailsGatewayPort, GetGuardrailsRoute(instance), openAIAPIPath)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Guardrails orchestrator configuration", func() {
+	It("should use the explicit port of the URL", func() {
+		service, err := GetGuardrailsService("http://detector.models.svc.cluster.local:8000")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service).To(Equal(map[string]interface{}{
+			"hostname": "detector.models.svc.cluster.local",
+			"port":     8000,
+		}))
+	})
+
+	It("should default the port and enable TLS for https URLs", func() {
+		service, err := GetGuardrailsService("https://llm.example.com/v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service).To(Equal(map[string]interface{}{
+			"hostname": "llm.example.com",
+			"port":     443,
+			"tls":      "llm",
+		}))
+	})
+
+	It("should reject URLs without a host", func() {
+		_, err := GetGuardrailsService("detector:8000")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should verify the LLM certificate with the CA bundle of the LLM", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.LLMEndpoint = "https://llm.example.com/v1"
+		instance.Spec.TLSCACertBundle = "llm-ca"
+		instance.Spec.Guardrails = &apiv1beta1.GuardrailsSpec{}
+		instance.Status.Guardrails = &apiv1beta1.GuardrailsStatus{}
+		Expect(GetLLMTLSCACertBundle(instance)).To(Equal(ServiceCACertBundle))
+		Expect(IsReferencedCACertBundle(instance, "llm-ca", "")).To(BeTrue())
+
+		configYAML, err := GetGuardrailsOrchestratorConfig(instance)
+		Expect(err).NotTo(HaveOccurred())
+
+		var config map[string]interface{}
+		Expect(yaml.Unmarshal([]byte(configYAML), &config)).To(Succeed())
+		Expect(config["tls"]).To(Equal(map[string]interface{}{
+			"llm": map[string]interface{}{
+				"insecure":            false,
+				"client_ca_cert_path": "/etc/tls/openshift-ai-lightspeed-guardrails-llm-ca/ca.crt",
+			},
+		}))
+
+		instance.Spec.TLSCACertBundle = ""
+		configYAML, err = GetGuardrailsOrchestratorConfig(instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configYAML).NotTo(ContainSubstring("client_ca_cert_path"))
+	})
+})
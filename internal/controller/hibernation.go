@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the scheduled hibernation of the OLS app server.
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	"k8s.io/utils/ptr"
+)
+
+// ValidateHibernation returns an error if a hibernation window is invalid.
+func ValidateHibernation(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	if instance.Spec.Hibernation == nil {
+		return nil
+	}
+
+	for i, window := range instance.Spec.Hibernation.Windows {
+		if err := ValidateTimeWindow(window.Schedule, window.Duration.Duration, window.TimeZone); err != nil {
+			return fmt.Errorf("hibernation.windows[%d] is invalid: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// GetHibernation returns whether the OLS app server hibernates at now, the end of the hibernation, and the
+// delay after which it must be checked again: the end of the hibernation or the start of the next window.
+func GetHibernation(instance *apiv1beta1.OpenShiftAILightspeed, now time.Time) (bool, time.Time, time.Duration, error) {
+	if instance.Spec.Hibernation == nil {
+		return false, time.Time{}, 0, nil
+	}
+
+	var hibernationEnd time.Time
+	var checkDelay time.Duration
+	for _, window := range instance.Spec.Hibernation.Windows {
+		isInWindow, nextStart, err := GetTimeWindow(window.Schedule, window.Duration.Duration, window.TimeZone, now)
+		if err != nil {
+			return false, time.Time{}, 0, err
+		}
+
+		transition := nextStart
+		if isInWindow {
+			windowEnd, _, err := GetTimeWindowEnd(window.Schedule, window.Duration.Duration, window.TimeZone, now)
+			if err != nil {
+				return false, time.Time{}, 0, err
+			}
+			if windowEnd.After(hibernationEnd) {
+				hibernationEnd = windowEnd
+			}
+			transition = windowEnd
+		}
+
+		if delay := transition.Sub(now); delay > 0 && (checkDelay == 0 || delay < checkDelay) {
+			checkDelay = delay
+		}
+	}
+
+	return !hibernationEnd.IsZero(), hibernationEnd, checkDelay, nil
+}
+
+// Hibernate scales the OLS app server down to zero replicas by overriding the OLSServer settings of the
+// instance, which are then rendered into the OLSConfig.
+func Hibernate(instance *apiv1beta1.OpenShiftAILightspeed) {
+	server := &apiv1beta1.OLSServerSpec{}
+	if instance.Spec.OLSServer != nil {
+		server = instance.Spec.OLSServer.DeepCopy()
+	}
+	server.Replicas = ptr.To[int32](0)
+	instance.Spec.OLSServer = server
+}
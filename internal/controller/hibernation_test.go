@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Hibernation", func() {
+	newInstance := func(windows ...apiv1beta1.MaintenanceWindow) *apiv1beta1.OpenShiftAILightspeed {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.Hibernation = &apiv1beta1.HibernationSpec{Windows: windows}
+		return instance
+	}
+
+	// Every night from 20:00 to 8:00 in New York, and all the weekend in UTC
+	nightly := apiv1beta1.MaintenanceWindow{
+		Schedule: "0 20 * * *",
+		Duration: metav1.Duration{Duration: 12 * time.Hour},
+		TimeZone: "America/New_York",
+	}
+	weekend := apiv1beta1.MaintenanceWindow{
+		Schedule: "0 0 * * 6",
+		Duration: metav1.Duration{Duration: 48 * time.Hour},
+	}
+
+	It("should not hibernate without windows", func() {
+		isHibernating, _, checkDelay, err := GetHibernation(&apiv1beta1.OpenShiftAILightspeed{}, time.Now())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isHibernating).To(BeFalse())
+		Expect(checkDelay).To(BeZero())
+	})
+
+	It("should hibernate within a window until its end", func() {
+		// Wednesday 2025-06-04 at 2:00 in New York
+		now := time.Date(2025, 6, 4, 6, 0, 0, 0, time.UTC)
+
+		isHibernating, hibernationEnd, checkDelay, err := GetHibernation(newInstance(nightly, weekend), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isHibernating).To(BeTrue())
+		Expect(hibernationEnd).To(BeTemporally("==", time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC)))
+		Expect(checkDelay).To(Equal(6 * time.Hour))
+	})
+
+	It("should wake up between the windows until the next one starts", func() {
+		// Wednesday 2025-06-04 at 10:00 in New York
+		now := time.Date(2025, 6, 4, 14, 0, 0, 0, time.UTC)
+
+		isHibernating, _, checkDelay, err := GetHibernation(newInstance(nightly, weekend), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isHibernating).To(BeFalse())
+		Expect(checkDelay).To(Equal(10 * time.Hour))
+	})
+
+	It("should hibernate until the end of the last overlapping window", func() {
+		// Saturday 2025-06-07 at 2:00 in New York
+		now := time.Date(2025, 6, 7, 6, 0, 0, 0, time.UTC)
+
+		isHibernating, hibernationEnd, checkDelay, err := GetHibernation(newInstance(nightly, weekend), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isHibernating).To(BeTrue())
+		Expect(hibernationEnd).To(BeTemporally("==", time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)))
+		Expect(checkDelay).To(Equal(6 * time.Hour))
+	})
+
+	It("should scale the OLS app server down to zero replicas", func() {
+		instance := newInstance(nightly)
+		instance.Spec.OLSServer = &apiv1beta1.OLSServerSpec{Replicas: ptr.To[int32](3)}
+		server := instance.Spec.OLSServer
+
+		Hibernate(instance)
+		Expect(*instance.Spec.OLSServer.Replicas).To(BeZero())
+		Expect(*server.Replicas).To(Equal(int32(3)))
+
+		instance = newInstance(nightly)
+		Hibernate(instance)
+		Expect(*instance.Spec.OLSServer.Replicas).To(BeZero())
+	})
+
+	It("should reject invalid windows", func() {
+		Expect(ValidateHibernation(newInstance(nightly, weekend))).To(Succeed())
+		Expect(ValidateHibernation(newInstance(apiv1beta1.MaintenanceWindow{
+			Schedule: "0 20 * *",
+			Duration: metav1.Duration{Duration: time.Hour},
+		}))).To(MatchError(ContainSubstring("hibernation.windows[0]")))
+	})
+})
@@ -0,0 +1,203 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for resolving the LLM settings from a KServe InferenceService.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ServiceCACertBundle - name of the ConfigMap that OpenShift injects into every namespace with the CA
+	// bundle of the service serving certificates
+	ServiceCACertBundle = "openshift-service-ca.crt"
+
+	// openAIAPIPath - path of the OpenAI compatible API served by the vLLM runtimes
+	openAIAPIPath = "/v1"
+
+	// InferenceServiceDeploymentModeAnnotation - annotation selecting how KServe deploys an InferenceService
+	InferenceServiceDeploymentModeAnnotation = "serving.kserve.io/deploymentMode"
+
+	// InferenceServiceServerless - deployment mode of the InferenceServices served by Knative
+	InferenceServiceServerless = "Serverless"
+
+	// InferenceServiceRawDeployment - deployment mode of the InferenceServices served by a Deployment and a
+	// Service
+	InferenceServiceRawDeployment = "RawDeployment"
+)
+
+// InferenceServiceGVK - GroupVersionKind of KServe InferenceServices
+var InferenceServiceGVK = schema.GroupVersionKind{
+	Group:   "serving.kserve.io",
+	Version: "v1beta1",
+	Kind:    "InferenceService",
+}
+
+// GetInferenceService returns the InferenceService referenced by ref. An empty namespace in ref defaults
+// to the namespace of the instance.
+func GetInferenceService(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	ref *apiv1beta1.InferenceServiceReference,
+) (*uns.Unstructured, error) {
+	// InferenceServices are cached in all namespaces, see ClusterWideWatchedGVKs
+	inferenceService := &uns.Unstructured{}
+	inferenceService.SetGroupVersionKind(InferenceServiceGVK)
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: GetInferenceServiceNamespace(instance, ref),
+	}, inferenceService)
+	if err != nil {
+		return nil, err
+	}
+
+	return inferenceService, nil
+}
+
+// ResolveInferenceService resolves the LLM URL, model name and CA bundle from the InferenceService
+// returned by GetInferenceServiceRef and stores them in the instance status. Returns true if the
+// InferenceService is ready, and a message describing why it is not ready otherwise.
+func ResolveInferenceService(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, string, error) {
+	inferenceService, err := GetInferenceService(ctx, helper, instance, GetInferenceServiceRef(instance))
+	if err != nil {
+		return false, "", err
+	}
+
+	ready, message, err := IsObjectReady(inferenceService)
+	if err != nil || !ready {
+		return false, message, err
+	}
+
+	predictorURL, err := GetInferenceServiceURL(inferenceService)
+	if err != nil {
+		return false, "", err
+	}
+
+	status := &apiv1beta1.InferenceServiceStatus{
+		URL:       predictorURL.String(),
+		ModelName: inferenceService.GetName(),
+	}
+
+	// Predictors exposed only inside of the cluster use certificates signed by the service CA
+	if predictorURL.Scheme == "https" && IsClusterLocalHost(predictorURL.Hostname()) {
+		status.CACertBundle = ServiceCACertBundle
+	}
+
+	instance.Status.InferenceService = status
+
+	return true, "", nil
+}
+
+// GetInferenceServiceURL returns the URL of the OpenAI compatible API of the InferenceService. The
+// cluster-local address is preferred over the external URL so that the traffic stays inside the cluster.
+func GetInferenceServiceURL(inferenceService *uns.Unstructured) (*url.URL, error) {
+	rawURL, _, err := uns.NestedString(inferenceService.Object, "status", "address", "url")
+	if err != nil {
+		return nil, err
+	}
+
+	if rawURL == "" {
+		rawURL, _, err = uns.NestedString(inferenceService.Object, "status", "url")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rawURL == "" {
+		return nil, fmt.Errorf("InferenceService %s has no URL", inferenceService.GetName())
+	}
+
+	predictorURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(predictorURL.Path, openAIAPIPath) {
+		predictorURL.Path = strings.TrimSuffix(predictorURL.Path, "/") + openAIAPIPath
+	}
+
+	return predictorURL, nil
+}
+
+// GetInferenceServiceDeploymentMode returns the mode KServe deployed the InferenceService with. The mode
+// reported in the status takes precedence over the annotation, which is not set when the InferenceService
+// uses the default mode of the cluster.
+func GetInferenceServiceDeploymentMode(inferenceService *uns.Unstructured) string {
+	if deploymentMode, _, _ := uns.NestedString(inferenceService.Object, "status", "deploymentMode"); deploymentMode != "" {
+		return deploymentMode
+	}
+
+	return inferenceService.GetAnnotations()[InferenceServiceDeploymentModeAnnotation]
+}
+
+// IsClusterLocalHost returns true if host is the DNS name of a Service inside of the cluster.
+func IsClusterLocalHost(host string) bool {
+	return strings.HasSuffix(host, ".svc") || strings.HasSuffix(host, ".svc.cluster.local")
+}
+
+// GetInferenceServiceRef returns the InferenceService the LLM settings are resolved from. It is either the
+// InferenceService referenced in InferenceServiceRef or the one of the managed model. Returns nil when the
+// LLM settings are not resolved from an InferenceService.
+func GetInferenceServiceRef(instance *apiv1beta1.OpenShiftAILightspeed) *apiv1beta1.InferenceServiceReference {
+	if instance.Spec.InferenceServiceRef != nil {
+		return instance.Spec.InferenceServiceRef
+	}
+
+	return GetManagedModelInferenceServiceRef(instance)
+}
+
+// GetInferenceServiceNamespace returns the namespace of the InferenceService referenced by ref, which
+// defaults to the namespace of the instance.
+func GetInferenceServiceNamespace(
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	ref *apiv1beta1.InferenceServiceReference,
+) string {
+	if ref.Namespace != "" {
+		return ref.Namespace
+	}
+
+	return instance.Namespace
+}
+
+// IsReferencedInferenceService returns true if the InferenceService identified by name and namespace is
+// referenced by the instance, either to resolve the LLM settings or to grant access to it.
+func IsReferencedInferenceService(instance *apiv1beta1.OpenShiftAILightspeed, name string, namespace string) bool {
+	for _, ref := range []*apiv1beta1.InferenceServiceReference{
+		GetInferenceServiceRef(instance),
+		GetServiceAccountAuthInferenceService(instance),
+	} {
+		if ref != nil && ref.Name == name && GetInferenceServiceNamespace(instance, ref) == namespace {
+			return true
+		}
+	}
+
+	return false
+}
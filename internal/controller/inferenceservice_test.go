@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+)
+
+// newInferenceService returns an InferenceService with the given status
+func newInferenceService(name string, namespace string, status map[string]interface{}) *uns.Unstructured {
+	inferenceService := &uns.Unstructured{Object: map[string]interface{}{"status": status}}
+	inferenceService.SetGroupVersionKind(InferenceServiceGVK)
+	inferenceService.SetName(name)
+	inferenceService.SetNamespace(namespace)
+
+	return inferenceService
+}
+
+// newInferenceServiceHelper returns a helper whose client holds the given objects
+func newInferenceServiceHelper(instance *apiv1beta1.OpenShiftAILightspeed, objs ...client.Object) *common_helper.Helper {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(apiv1beta1.AddToScheme(scheme)).To(Succeed())
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(InferenceServiceGVK, meta.RESTScopeNamespace)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(restMapper).WithObjects(objs...).Build()
+	helper, err := common_helper.NewHelper(instance, fakeClient, nil, scheme, logr.Discard())
+	Expect(err).NotTo(HaveOccurred())
+
+	return helper
+}
+
+var _ = Describe("InferenceService", func() {
+	readyCondition := []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}}
+
+	It("should prefer the cluster-local address over the external URL", func() {
+		inferenceService := newInferenceService("granite", "models", map[string]interface{}{
+			"address": map[string]interface{}{"url": "https://granite-predictor.models.svc.cluster.local"},
+			"url":     "https://granite-models.apps.example.com",
+		})
+
+		predictorURL, err := GetInferenceServiceURL(inferenceService)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predictorURL.String()).To(Equal("https://granite-predictor.models.svc.cluster.local/v1"))
+	})
+
+	It("should fall back to the external URL", func() {
+		inferenceService := newInferenceService("granite", "models", map[string]interface{}{
+			"url": "https://granite-models.apps.example.com/v1",
+		})
+
+		predictorURL, err := GetInferenceServiceURL(inferenceService)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predictorURL.String()).To(Equal("https://granite-models.apps.example.com/v1"))
+
+		_, err = GetInferenceServiceURL(newInferenceService("granite", "models", map[string]interface{}{}))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should detect the hosts of Services inside of the cluster", func() {
+		Expect(IsClusterLocalHost("granite-predictor.models.svc")).To(BeTrue())
+		Expect(IsClusterLocalHost("granite-predictor.models.svc.cluster.local")).To(BeTrue())
+		Expect(IsClusterLocalHost("granite-models.apps.example.com")).To(BeFalse())
+	})
+
+	It("should resolve the LLM settings of a ready InferenceService", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "openshift-lightspeed"
+		instance.Spec.InferenceServiceRef = &apiv1beta1.InferenceServiceReference{Name: "granite", Namespace: "models"}
+
+		inferenceService := newInferenceService("granite", "models", map[string]interface{}{
+			"address":    map[string]interface{}{"url": "https://granite-predictor.models.svc.cluster.local"},
+			"conditions": readyCondition,
+		})
+		helper := newInferenceServiceHelper(instance, inferenceService)
+
+		isReady, message, err := ResolveInferenceService(context.Background(), helper, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isReady).To(BeTrue())
+		Expect(message).To(BeEmpty())
+		Expect(instance.Status.InferenceService).To(Equal(&apiv1beta1.InferenceServiceStatus{
+			URL:          "https://granite-predictor.models.svc.cluster.local/v1",
+			ModelName:    "granite",
+			CACertBundle: ServiceCACertBundle,
+		}))
+	})
+
+	It("should wait for an InferenceService that is not ready", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "openshift-lightspeed"
+		instance.Spec.InferenceServiceRef = &apiv1beta1.InferenceServiceReference{Name: "granite"}
+
+		inferenceService := newInferenceService("granite", "openshift-lightspeed", map[string]interface{}{
+			"url": "https://granite-openshift-lightspeed.apps.example.com",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "message": "predictor is not ready"},
+			},
+		})
+		helper := newInferenceServiceHelper(instance, inferenceService)
+
+		isReady, message, err := ResolveInferenceService(context.Background(), helper, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isReady).To(BeFalse())
+		Expect(message).To(Equal("predictor is not ready"))
+		Expect(instance.Status.InferenceService).To(BeNil())
+	})
+
+	It("should return an error for a missing InferenceService", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "openshift-lightspeed"
+		instance.Spec.InferenceServiceRef = &apiv1beta1.InferenceServiceReference{Name: "granite", Namespace: "models"}
+		helper := newInferenceServiceHelper(instance)
+
+		isReady, _, err := ResolveInferenceService(context.Background(), helper, instance)
+		Expect(k8s_errors.IsNotFound(err)).To(BeTrue())
+		Expect(isReady).To(BeFalse())
+	})
+
+	It("should only require the LLM endpoint and the model name without an InferenceService", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.LLMEndpointType = "openai"
+		instance.Spec.LLMCredentials = "llm-credentials"
+
+		Expect(ValidateOpenShiftAILightspeed(instance)).To(MatchError(ContainSubstring("llmEndpoint")))
+
+		instance.Spec.LLMEndpoint = "https://llm.example.com/v1"
+		Expect(ValidateOpenShiftAILightspeed(instance)).To(MatchError(ContainSubstring("modelName")))
+
+		instance.Spec.ModelName = "granite"
+		Expect(ValidateOpenShiftAILightspeed(instance)).To(Succeed())
+
+		instance.Spec.LLMEndpoint = ""
+		instance.Spec.ModelName = ""
+		instance.Spec.InferenceServiceRef = &apiv1beta1.InferenceServiceReference{Name: "granite"}
+		Expect(ValidateOpenShiftAILightspeed(instance)).To(Succeed())
+	})
+})
@@ -0,0 +1,207 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+)
+
+// LightspeedRAGSourceReconciler reconciles a LightspeedRAGSource object
+type LightspeedRAGSourceReconciler struct {
+	client.Client
+	Scheme  *runtime.Scheme
+	Kclient kubernetes.Interface
+}
+
+// GetLogger returns a logger object with a prefix of "controller.name" and additional controller context fields
+func (r *LightspeedRAGSourceReconciler) GetLogger(ctx context.Context) logr.Logger {
+	return log.FromContext(ctx).WithName("Controllers").WithName("LightspeedRAGSource")
+}
+
+// +kubebuilder:rbac:groups=lightspeed.openshift-ai.io,resources=lightspeedragsources,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=lightspeed.openshift-ai.io,resources=lightspeedragsources/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=lightspeed.openshift-ai.io,resources=lightspeedragsources/finalizers,verbs=update
+
+// Reconcile indexes the documents referenced in a LightspeedRAGSource into a RAG image. The objects created
+// for the indexing are owned by the LightspeedRAGSource and garbage collected with it. The
+// OpenShiftAILightspeed controller adds the indexed RAG images to the OLSConfig.
+func (r *LightspeedRAGSourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	Log := r.GetLogger(ctx)
+	Log.Info("LightspeedRAGSource Reconciling")
+
+	instance := &apiv1beta1.LightspeedRAGSource{}
+	err := r.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			Log.Info("LightspeedRAGSource CR not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	helper, err := common_helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Save a copy of the conditions so that we can restore the LastTransitionTime
+	// when a condition's state doesn't change.
+	savedConditions := instance.Status.Conditions.DeepCopy()
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// Don't update the status, if reconciler Panics
+		if r := recover(); r != nil {
+			Log.Info(fmt.Sprintf("panic during reconcile %v\n", r))
+			panic(r)
+		}
+
+		condition.RestoreLastTransitionTimes(&instance.Status.Conditions, &savedConditions)
+		// update the Ready condition based on the sub conditions
+		if instance.Status.Conditions.AllSubConditionIsTrue() {
+			instance.Status.Conditions.MarkTrue(
+				condition.ReadyCondition, condition.ReadyMessage)
+		} else {
+			// something is not ready so reset the Ready condition
+			instance.Status.Conditions.MarkUnknown(
+				condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+			// and recalculate it based on the state of the rest of the conditions
+			instance.Status.Conditions.Set(
+				instance.Status.Conditions.Mirror(condition.ReadyCondition))
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			return
+		}
+	}()
+
+	cl := condition.CreateList(
+		condition.UnknownCondition(
+			apiv1beta1.LightspeedRAGSourceIndexReadyCondition,
+			condition.InitReason,
+			apiv1beta1.LightspeedRAGSourceIndexWaitingMessage,
+		),
+	)
+
+	instance.Status.Conditions.Init(&cl)
+	instance.Status.ObservedGeneration = instance.Generation
+
+	if instance.Spec.IndexerImage == "" {
+		instance.Spec.IndexerImage = apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGIndexerImageURL
+	}
+
+	if instance.Spec.EmbeddingModel == "" {
+		instance.Spec.EmbeddingModel = apiv1beta1.OpenShiftAILightspeedDefaultValues.EmbeddingModel
+	}
+
+	err = ValidateLightspeedRAGSource(instance)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.LightspeedRAGSourceIndexReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityError,
+			apiv1beta1.LightspeedRAGSourceIndexErrorMessage,
+			err.Error(),
+		))
+
+		// Retrying won't help until the user fixes the spec, which triggers a new reconciliation.
+		return ctrl.Result{}, nil
+	}
+
+	isIndexed, err := EnsureRAGSourceIndex(ctx, helper, instance)
+	var jobFailedErr *JobFailedError
+	if errors.As(err, &jobFailedErr) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.LightspeedRAGSourceIndexReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityError,
+			apiv1beta1.LightspeedRAGSourceIndexErrorMessage,
+			err.Error(),
+		))
+
+		// The indexing is not retried until the spec changes, which triggers a new reconciliation.
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.LightspeedRAGSourceIndexReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			apiv1beta1.LightspeedRAGSourceIndexErrorMessage,
+			err.Error(),
+		))
+
+		// The RAG images are built with OpenShift builds, which are not available on every cluster
+		if meta.IsNoMatchError(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	} else if !isIndexed {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.LightspeedRAGSourceIndexReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			apiv1beta1.LightspeedRAGSourceIndexWaitingMessage,
+		))
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	instance.Status.Conditions.MarkTrue(
+		apiv1beta1.LightspeedRAGSourceIndexReadyCondition,
+		apiv1beta1.LightspeedRAGSourceIndexReadyMessage,
+	)
+
+	Log.Info("LightspeedRAGSource Reconciled successfully")
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LightspeedRAGSourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apiv1beta1.LightspeedRAGSource{}).
+		Owns(&batchv1.Job{}).
+		Owns(&corev1.ServiceAccount{}).
+		Complete(r)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
+)
+
+var _ = Describe("LightspeedRAGSource Controller", func() {
+	const resourceName = "runbooks"
+	const namespace = "openshift-lightspeed"
+
+	ctx := context.Background()
+	typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: namespace}
+	jobKey := client.ObjectKey{Name: LightspeedRAGSourcePrefix + resourceName, Namespace: namespace}
+
+	var fakeClient client.Client
+	var controllerReconciler *LightspeedRAGSourceReconciler
+
+	// newReconciler returns a reconciler whose client holds the given source and serves the OpenShift build
+	// APIs if withBuilds is set
+	newReconciler := func(source *apiv1beta1.LightspeedRAGSource, withBuilds bool) {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1beta1.AddToScheme(scheme)).To(Succeed())
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(ImageStreamGVK, meta.RESTScopeNamespace)
+		restMapper.Add(BuildConfigGVK, meta.RESTScopeNamespace)
+		restMapper.Add(BuildGVK, meta.RESTScopeNamespace)
+
+		builder := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithRESTMapper(restMapper).
+			WithObjects(source).
+			WithStatusSubresource(source)
+		if !withBuilds {
+			// The fake client does not look the unstructured objects up in the RESTMapper
+			builder = builder.WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(
+					ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object,
+					opts ...client.GetOption,
+				) error {
+					if u, ok := obj.(*uns.Unstructured); ok {
+						gvk := u.GroupVersionKind()
+						return &meta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			})
+		}
+
+		fakeClient = builder.Build()
+		controllerReconciler = &LightspeedRAGSourceReconciler{Client: fakeClient, Scheme: scheme}
+	}
+
+	reconcileSource := func() (reconcile.Result, *apiv1beta1.LightspeedRAGSource) {
+		result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+		Expect(err).NotTo(HaveOccurred())
+
+		source := &apiv1beta1.LightspeedRAGSource{}
+		Expect(fakeClient.Get(ctx, typeNamespacedName, source)).To(Succeed())
+		return result, source
+	}
+
+	getJob := func() *batchv1.Job {
+		job := &batchv1.Job{}
+		Expect(fakeClient.Get(ctx, jobKey, job)).To(Succeed())
+		return job
+	}
+
+	// expectedHash returns the hash of the spec with the defaults the controller applies
+	expectedHash := func(source *apiv1beta1.LightspeedRAGSource) string {
+		spec := source.Spec.DeepCopy()
+		spec.IndexerImage = apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGIndexerImageURL
+		spec.EmbeddingModel = apiv1beta1.OpenShiftAILightspeedDefaultValues.EmbeddingModel
+		hash, err := GetSpecHash(*spec)
+		Expect(err).NotTo(HaveOccurred())
+		return hash
+	}
+
+	var resource *apiv1beta1.LightspeedRAGSource
+
+	BeforeEach(func() {
+		resource = &apiv1beta1.LightspeedRAGSource{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: namespace, Generation: 1},
+			Spec: apiv1beta1.LightspeedRAGSourceSpec{
+				ConfigMap: &apiv1beta1.ConfigMapDocumentSource{Name: "runbooks"},
+			},
+		}
+	})
+
+	It("should create the indexing Job with the hash of the spec", func() {
+		newReconciler(resource, true)
+
+		result, source := reconcileSource()
+		Expect(result.RequeueAfter).To(Equal(10 * time.Second))
+
+		job := getJob()
+		Expect(job.GetAnnotations()).To(HaveKeyWithValue(LightspeedRAGSourceHashAnnotation, expectedHash(resource)))
+		Expect(metav1.IsControlledBy(job, source)).To(BeTrue())
+
+		indexReady := source.Status.Conditions.Get(apiv1beta1.LightspeedRAGSourceIndexReadyCondition)
+		Expect(indexReady).NotTo(BeNil())
+		Expect(indexReady.Status).To(Equal(corev1.ConditionFalse))
+		Expect(indexReady.Reason).To(Equal(condition.RequestedReason))
+		Expect(source.Status.ObservedGeneration).To(Equal(int64(1)))
+	})
+
+	It("should keep a failed Job until the spec changes", func() {
+		newReconciler(resource, true)
+		reconcileSource()
+
+		job := getJob()
+		job.Status.Conditions = []batchv1.JobCondition{{
+			Type:    batchv1.JobFailed,
+			Status:  corev1.ConditionTrue,
+			Message: "Job has reached the specified backoff limit",
+		}}
+		Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+
+		for range 2 {
+			result, source := reconcileSource()
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			indexReady := source.Status.Conditions.Get(apiv1beta1.LightspeedRAGSourceIndexReadyCondition)
+			Expect(indexReady.Status).To(Equal(corev1.ConditionFalse))
+			Expect(indexReady.Reason).To(Equal(condition.ErrorReason))
+			Expect(indexReady.Severity).To(Equal(condition.SeverityError))
+			Expect(getJob().UID).To(Equal(job.UID))
+		}
+
+		By("changing the spec")
+		source := &apiv1beta1.LightspeedRAGSource{}
+		Expect(fakeClient.Get(ctx, typeNamespacedName, source)).To(Succeed())
+		source.Spec.ConfigMap.Name = "runbooks-v2"
+		Expect(fakeClient.Update(ctx, source)).To(Succeed())
+
+		reconcileSource()
+		err := fakeClient.Get(ctx, jobKey, &batchv1.Job{})
+		Expect(k8s_errors.IsNotFound(err)).To(BeTrue())
+
+		reconcileSource()
+		Expect(getJob().GetAnnotations()).To(
+			HaveKeyWithValue(LightspeedRAGSourceHashAnnotation, expectedHash(source)))
+	})
+
+	It("should store the image of the build and mark the index ready", func() {
+		newReconciler(resource, true)
+		reconcileSource()
+
+		job := getJob()
+		job.Status.Succeeded = 1
+		Expect(fakeClient.Status().Update(ctx, job)).To(Succeed())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      job.Name + "-abcde",
+				Namespace: namespace,
+				Labels:    map[string]string{batchv1.JobNameLabel: job.Name},
+			},
+		}
+		Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+		pod.Status = corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: ragIndexingBuildContainerName,
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Message: "BUILD=" + job.Name + "-1\n"},
+				},
+			}},
+		}
+		Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+
+		build := &uns.Unstructured{}
+		build.SetGroupVersionKind(BuildGVK)
+		build.SetName(job.Name + "-1")
+		build.SetNamespace(namespace)
+		Expect(uns.SetNestedMap(build.Object, map[string]interface{}{
+			"outputDockerImageReference": "image-registry.openshift-image-registry.svc:5000/" +
+				namespace + "/" + job.Name + ":latest",
+			"output": map[string]interface{}{
+				"to": map[string]interface{}{"imageDigest": "sha256:0123"},
+			},
+		}, "status")).To(Succeed())
+		Expect(fakeClient.Create(ctx, build)).To(Succeed())
+
+		result, source := reconcileSource()
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(source.Status.Image).To(Equal(
+			"image-registry.openshift-image-registry.svc:5000/" + namespace + "/" + job.Name + "@sha256:0123"))
+		Expect(source.Status.IndexPath).To(Equal("/rag/vector_db/runbooks"))
+		Expect(source.Status.SourceHash).To(Equal(expectedHash(resource)))
+		Expect(source.Status.Conditions.IsTrue(apiv1beta1.LightspeedRAGSourceIndexReadyCondition)).To(BeTrue())
+
+		err := fakeClient.Get(ctx, jobKey, &batchv1.Job{})
+		Expect(k8s_errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should report a missing OpenShift build API without requeueing", func() {
+		newReconciler(resource, false)
+
+		result, source := reconcileSource()
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		indexReady := source.Status.Conditions.Get(apiv1beta1.LightspeedRAGSourceIndexReadyCondition)
+		Expect(indexReady.Status).To(Equal(corev1.ConditionFalse))
+		Expect(indexReady.Severity).To(Equal(condition.SeverityWarning))
+		err := fakeClient.Get(ctx, jobKey, &batchv1.Job{})
+		Expect(k8s_errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should reject an invalid spec", func() {
+		resource.Spec.IndexID = "ops/runbooks"
+		newReconciler(resource, true)
+
+		result, source := reconcileSource()
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		indexReady := source.Status.Conditions.Get(apiv1beta1.LightspeedRAGSourceIndexReadyCondition)
+		Expect(indexReady.Status).To(Equal(corev1.ConditionFalse))
+		Expect(indexReady.Severity).To(Equal(condition.SeverityError))
+		err := fakeClient.Get(ctx, jobKey, &batchv1.Job{})
+		Expect(k8s_errors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for resolving the LLM settings from a LlamaStackDistribution.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LlamaStackProviderType - type of the OLS provider used for LlamaStackDistributions, which serve an
+	// OpenAI compatible API
+	LlamaStackProviderType = "openai"
+
+	// llamaStackDefaultPort - port the Llama Stack server listens on unless configured otherwise
+	llamaStackDefaultPort = 8321
+
+	// llamaStackOpenAIAPIPath - path of the OpenAI compatible API served by Llama Stack
+	llamaStackOpenAIAPIPath = "/v1/openai/v1"
+
+	// llamaStackReadyPhase - phase of a LlamaStackDistribution that is ready to serve requests
+	llamaStackReadyPhase = "Ready"
+)
+
+// LlamaStackDistributionGVK - GroupVersionKind of LlamaStackDistributions
+var LlamaStackDistributionGVK = schema.GroupVersionKind{
+	Group:   "llamastack.io",
+	Version: "v1alpha1",
+	Kind:    "LlamaStackDistribution",
+}
+
+// llamaStackHTTPClient - client used to list the models served by LlamaStackDistributions
+var llamaStackHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// ResolveLlamaStackDistribution resolves the URL of the OpenAI compatible API and the models served by the
+// LlamaStackDistribution referenced in LlamaStackDistributionRef and stores them in the instance status. The
+// models are listed again only when the LlamaStackDistribution changes. Returns true if the
+// LlamaStackDistribution is ready and serves the model to use, and a message describing why it is not ready
+// otherwise. The returned error is a ModelNotServedError when the model to use is not served.
+func ResolveLlamaStackDistribution(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, string, error) {
+	// LlamaStackDistributions are cached in all namespaces, see ClusterWideWatchedGVKs
+	distribution := &uns.Unstructured{}
+	distribution.SetGroupVersionKind(LlamaStackDistributionGVK)
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      instance.Spec.LlamaStackDistributionRef.Name,
+		Namespace: GetLlamaStackDistributionNamespace(instance),
+	}, distribution)
+	if err != nil {
+		return false, "", err
+	}
+
+	phase, _, err := uns.NestedString(distribution.Object, "status", "phase")
+	if err != nil {
+		return false, "", err
+	}
+	if phase != llamaStackReadyPhase {
+		return false, fmt.Sprintf("LlamaStackDistribution is in the %q phase", phase), nil
+	}
+
+	apiURL, err := GetLlamaStackDistributionURL(distribution)
+	if err != nil {
+		return false, "", err
+	}
+
+	llamaStack := instance.Status.LlamaStack
+	if llamaStack == nil || llamaStack.URL != apiURL || llamaStack.ResourceVersion != distribution.GetResourceVersion() {
+		models, err := ListLlamaStackModels(ctx, apiURL)
+		if err != nil {
+			return false, "", err
+		}
+
+		llamaStack = &apiv1beta1.LlamaStackStatus{
+			URL:             apiURL,
+			Models:          models,
+			ResourceVersion: distribution.GetResourceVersion(),
+		}
+		instance.Status.LlamaStack = llamaStack
+	}
+
+	if len(llamaStack.Models) == 0 {
+		return false, "LlamaStackDistribution does not serve any model", nil
+	}
+
+	if instance.Spec.ModelName != "" && !slices.Contains(llamaStack.Models, instance.Spec.ModelName) {
+		return false, "", &ModelNotServedError{Model: instance.Spec.ModelName, Models: llamaStack.Models}
+	}
+
+	return true, "", nil
+}
+
+// ModelNotServedError is returned when the model set in the spec is not served by the LlamaStackDistribution.
+// Retrying won't help until the spec or the LlamaStackDistribution is changed.
+type ModelNotServedError struct {
+	Model  string
+	Models []string
+}
+
+func (e *ModelNotServedError) Error() string {
+	return fmt.Sprintf("model %s is not served by the LlamaStackDistribution, available models: %s",
+		e.Model, strings.Join(e.Models, ", "))
+}
+
+// GetLlamaStackDistributionURL returns the URL of the OpenAI compatible API of the LlamaStackDistribution.
+// The service URL reported in the status is preferred, otherwise the URL of the service created by the
+// Llama Stack operator is used.
+func GetLlamaStackDistributionURL(distribution *uns.Unstructured) (string, error) {
+	serviceURL, _, err := uns.NestedString(distribution.Object, "status", "serviceURL")
+	if err != nil {
+		return "", err
+	}
+
+	if serviceURL == "" {
+		port, found, err := uns.NestedInt64(distribution.Object, "spec", "server", "containerSpec", "port")
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			port = llamaStackDefaultPort
+		}
+
+		serviceURL = fmt.Sprintf("http://%s-service.%s.svc.cluster.local:%d",
+			distribution.GetName(), distribution.GetNamespace(), port)
+	}
+
+	apiURL, err := url.Parse(serviceURL)
+	if err != nil {
+		return "", err
+	}
+	apiURL.Path = strings.TrimSuffix(apiURL.Path, "/") + llamaStackOpenAIAPIPath
+
+	return apiURL.String(), nil
+}
+
+// ListLlamaStackModels returns the IDs of the models served by the OpenAI compatible API at apiURL.
+func ListLlamaStackModels(ctx context.Context, apiURL string) ([]string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+"/models", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := llamaStackHTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing models at %s failed with status %s", apiURL, response.Status)
+	}
+
+	var modelList struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	err = json.NewDecoder(response.Body).Decode(&modelList)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]string, 0, len(modelList.Data))
+	for _, model := range modelList.Data {
+		models = append(models, model.ID)
+	}
+
+	return models, nil
+}
+
+// GetLlamaStackDistributionNamespace returns the namespace of the LlamaStackDistribution referenced in
+// LlamaStackDistributionRef, which defaults to the namespace of the instance.
+func GetLlamaStackDistributionNamespace(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Spec.LlamaStackDistributionRef.Namespace != "" {
+		return instance.Spec.LlamaStackDistributionRef.Namespace
+	}
+
+	return instance.Namespace
+}
+
+// IsReferencedLlamaStackDistribution returns true if the LlamaStackDistribution identified by name and
+// namespace is referenced in LlamaStackDistributionRef.
+func IsReferencedLlamaStackDistribution(instance *apiv1beta1.OpenShiftAILightspeed, name string, namespace string) bool {
+	return instance.Spec.LlamaStackDistributionRef != nil &&
+		instance.Spec.LlamaStackDistributionRef.Name == name &&
+		GetLlamaStackDistributionNamespace(instance) == namespace
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("LlamaStackDistribution", func() {
+	newDistribution := func() *uns.Unstructured {
+		distribution := &uns.Unstructured{}
+		distribution.SetGroupVersionKind(LlamaStackDistributionGVK)
+		distribution.SetName("llama")
+		distribution.SetNamespace("models")
+		return distribution
+	}
+
+	It("should prefer the service URL of the status", func() {
+		distribution := newDistribution()
+		Expect(uns.SetNestedField(distribution.Object, "http://llama.example.com:8080/", "status", "serviceURL")).
+			To(Succeed())
+
+		apiURL, err := GetLlamaStackDistributionURL(distribution)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiURL).To(Equal("http://llama.example.com:8080/v1/openai/v1"))
+	})
+
+	It("should fall back to the service of the Llama Stack operator", func() {
+		distribution := newDistribution()
+
+		apiURL, err := GetLlamaStackDistributionURL(distribution)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiURL).To(Equal("http://llama-service.models.svc.cluster.local:8321/v1/openai/v1"))
+
+		Expect(uns.SetNestedField(distribution.Object, int64(9000), "spec", "server", "containerSpec", "port")).
+			To(Succeed())
+		apiURL, err = GetLlamaStackDistributionURL(distribution)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apiURL).To(Equal("http://llama-service.models.svc.cluster.local:9000/v1/openai/v1"))
+	})
+
+	It("should list the models served by the OpenAI compatible API", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/v1/openai/v1/models"))
+			_, _ = w.Write([]byte(`{"object": "list", "data": [{"id": "granite"}, {"id": "llama"}]}`))
+		}))
+		defer server.Close()
+
+		models, err := ListLlamaStackModels(context.Background(), server.URL+"/v1/openai/v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models).To(Equal([]string{"granite", "llama"}))
+	})
+
+	It("should fail when the models cannot be listed", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		_, err := ListLlamaStackModels(context.Background(), server.URL)
+		Expect(err).To(MatchError(ContainSubstring("503")))
+	})
+})
@@ -0,0 +1,233 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for deploying a model served by KServe that is managed by the operator.
+package controller
+
+import (
+	"context"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// OpenShiftAILightspeedManagedModelName - name of the ServingRuntime and the InferenceService of the
+	// model managed by the operator. The InferenceService name is also the name vLLM serves the model as.
+	OpenShiftAILightspeedManagedModelName = "openshift-ai-lightspeed-model"
+
+	// ManagedModelProviderType - type of the OLS provider used for the model managed by the operator
+	ManagedModelProviderType = "rhoai_vllm"
+
+	// managedModelPort - port the vLLM runtime listens on
+	managedModelPort = 8080
+)
+
+// ServingRuntimeGVK - GroupVersionKind of KServe ServingRuntimes
+var ServingRuntimeGVK = schema.GroupVersionKind{
+	Group:   "serving.kserve.io",
+	Version: "v1alpha1",
+	Kind:    "ServingRuntime",
+}
+
+// EnsureManagedModel creates or updates the vLLM ServingRuntime and the InferenceService serving the
+// model described in ManagedModel. Both are owned by the instance. The InferenceService is deployed in
+// the RawDeployment mode with authorization enabled, OLS accesses it with the operator managed
+// ServiceAccount token.
+func EnsureManagedModel(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	managedModel := instance.Spec.ManagedModel
+
+	servingRuntime := &uns.Unstructured{}
+	servingRuntime.SetGroupVersionKind(ServingRuntimeGVK)
+	servingRuntime.SetName(OpenShiftAILightspeedManagedModelName)
+	servingRuntime.SetNamespace(instance.Namespace)
+
+	_, err := controllerutil.CreateOrPatch(ctx, helper.GetClient(), servingRuntime, func() error {
+		err := uns.SetNestedMap(servingRuntime.Object, GetManagedModelServingRuntimeSpec(managedModel), "spec")
+		if err != nil {
+			return err
+		}
+
+		return controllerutil.SetControllerReference(instance, servingRuntime, helper.GetScheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	model, err := GetManagedModelPredictorModel(managedModel)
+	if err != nil {
+		return err
+	}
+
+	inferenceService := &uns.Unstructured{}
+	inferenceService.SetGroupVersionKind(InferenceServiceGVK)
+	inferenceService.SetName(OpenShiftAILightspeedManagedModelName)
+	inferenceService.SetNamespace(instance.Namespace)
+
+	_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), inferenceService, func() error {
+		annotations := inferenceService.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[InferenceServiceDeploymentModeAnnotation] = InferenceServiceRawDeployment
+		annotations["security.opendatahub.io/enable-auth"] = "true"
+		inferenceService.SetAnnotations(annotations)
+
+		if err := uns.SetNestedMap(inferenceService.Object, model, "spec", "predictor", "model"); err != nil {
+			return err
+		}
+
+		return controllerutil.SetControllerReference(instance, inferenceService, helper.GetScheme())
+	})
+
+	return err
+}
+
+// GetManagedModelServingRuntimeSpec returns the spec of the vLLM ServingRuntime of the managed model in the
+// unstructured format.
+func GetManagedModelServingRuntimeSpec(managedModel *apiv1beta1.ManagedModelSpec) map[string]interface{} {
+	runtimeImage := managedModel.RuntimeImage
+	if runtimeImage == "" {
+		runtimeImage = apiv1beta1.OpenShiftAILightspeedDefaultValues.VLLMImageURL
+	}
+
+	return map[string]interface{}{
+		"multiModel": false,
+		"supportedModelFormats": []interface{}{
+			map[string]interface{}{
+				"name":       "vLLM",
+				"autoSelect": true,
+			},
+		},
+		"containers": []interface{}{
+			map[string]interface{}{
+				"name":    "kserve-container",
+				"image":   runtimeImage,
+				"command": []interface{}{"python", "-m", "vllm.entrypoints.openai.api_server"},
+				"args": []interface{}{
+					"--port=8080",
+					"--model=/mnt/models",
+					"--served-model-name={{.Name}}",
+				},
+				"env": []interface{}{
+					map[string]interface{}{
+						"name":  "HF_HOME",
+						"value": "/tmp/hf_home",
+					},
+				},
+				"ports": []interface{}{
+					map[string]interface{}{
+						"containerPort": int64(managedModelPort),
+						"protocol":      "TCP",
+					},
+				},
+			},
+		},
+	}
+}
+
+// GetManagedModelPredictorModel returns the model of the predictor of the InferenceService of the managed model
+// in the unstructured format.
+func GetManagedModelPredictorModel(managedModel *apiv1beta1.ManagedModelSpec) (map[string]interface{}, error) {
+	resources, err := GetManagedModelResources(managedModel)
+	if err != nil {
+		return nil, err
+	}
+
+	model := map[string]interface{}{
+		"modelFormat": map[string]interface{}{
+			"name": "vLLM",
+		},
+		"runtime":    OpenShiftAILightspeedManagedModelName,
+		"storageUri": managedModel.StorageURI,
+		"resources":  resources,
+	}
+
+	if len(managedModel.Args) > 0 {
+		args := make([]interface{}, 0, len(managedModel.Args))
+		for _, arg := range managedModel.Args {
+			args = append(args, arg)
+		}
+		model["args"] = args
+	}
+
+	return model, nil
+}
+
+// GetManagedModelResources returns the compute resources of the managed model server in the unstructured
+// format. When no resources are set in the spec a single accelerator of AcceleratorResource is requested.
+func GetManagedModelResources(managedModel *apiv1beta1.ManagedModelSpec) (map[string]interface{}, error) {
+	resources := managedModel.Resources
+	if resources == nil {
+		acceleratorResource := corev1.ResourceName(managedModel.AcceleratorResource)
+		if acceleratorResource == "" {
+			acceleratorResource = apiv1beta1.ManagedModelAcceleratorResourceDefault
+		}
+
+		accelerator := resource.MustParse("1")
+		resources = &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{acceleratorResource: accelerator},
+			Limits:   corev1.ResourceList{acceleratorResource: accelerator},
+		}
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(resources)
+}
+
+// RemoveManagedModel deletes the InferenceService and the ServingRuntime of the managed model if they
+// exist and are owned by the instance. Returns true once both are gone.
+func RemoveManagedModel(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, error) {
+	isRemoved := true
+
+	for _, gvk := range []schema.GroupVersionKind{InferenceServiceGVK, ServingRuntimeGVK} {
+		isObjectRemoved, err := RemoveInstanceOwnedObject(ctx, helper, instance, gvk, OpenShiftAILightspeedManagedModelName)
+		if err != nil {
+			return false, err
+		}
+		isRemoved = isRemoved && isObjectRemoved
+	}
+
+	return isRemoved, nil
+}
+
+// GetManagedModelInferenceServiceRef returns a reference to the InferenceService of the managed model or
+// nil when ManagedModel is not set.
+func GetManagedModelInferenceServiceRef(
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) *apiv1beta1.InferenceServiceReference {
+	if instance.Spec.ManagedModel == nil {
+		return nil
+	}
+
+	return &apiv1beta1.InferenceServiceReference{
+		Name:      OpenShiftAILightspeedManagedModelName,
+		Namespace: instance.Namespace,
+	}
+}
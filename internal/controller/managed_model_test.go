@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("Managed model", func() {
+	It("should render the vLLM ServingRuntime", func() {
+		spec := GetManagedModelServingRuntimeSpec(&apiv1beta1.ManagedModelSpec{
+			RuntimeImage: "quay.io/example/vllm:rocm",
+		})
+
+		containers, _, _ := uns.NestedSlice(spec, "containers")
+		Expect(containers).To(HaveLen(1))
+		container := containers[0].(map[string]interface{})
+		Expect(container).To(HaveKeyWithValue("image", "quay.io/example/vllm:rocm"))
+		Expect(container["args"]).To(ContainElement("--served-model-name={{.Name}}"))
+	})
+
+	It("should render the model of the InferenceService predictor", func() {
+		model, err := GetManagedModelPredictorModel(&apiv1beta1.ManagedModelSpec{
+			StorageURI: "oci://registry.example.com/granite:1.0",
+			Args:       []string{"--max-model-len=8192"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(model).To(HaveKeyWithValue("runtime", OpenShiftAILightspeedManagedModelName))
+		Expect(model).To(HaveKeyWithValue("storageUri", "oci://registry.example.com/granite:1.0"))
+		Expect(model).To(HaveKeyWithValue("args", []interface{}{"--max-model-len=8192"}))
+
+		limits, _, _ := uns.NestedStringMap(model, "resources", "limits")
+		Expect(limits).To(Equal(map[string]string{apiv1beta1.ManagedModelAcceleratorResourceDefault: "1"}))
+	})
+
+	It("should request the configured accelerator", func() {
+		resources, err := GetManagedModelResources(&apiv1beta1.ManagedModelSpec{
+			AcceleratorResource: "amd.com/gpu",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal(map[string]interface{}{
+			"requests": map[string]interface{}{"amd.com/gpu": "1"},
+			"limits":   map[string]interface{}{"amd.com/gpu": "1"},
+		}))
+	})
+
+	It("should give precedence to the resources of the spec", func() {
+		resources, err := GetManagedModelResources(&apiv1beta1.ManagedModelSpec{
+			AcceleratorResource: "amd.com/gpu",
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Gi")},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal(map[string]interface{}{
+			"limits": map[string]interface{}{"memory": "16Gi"},
+		}))
+	})
+})
@@ -0,0 +1,395 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the NetworkPolicy restricting the egress of the OLS app server.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// EgressNetworkPolicyName - name of the NetworkPolicy restricting the egress of the OLS app server
+	EgressNetworkPolicyName = "openshift-ai-lightspeed-egress"
+
+	// EgressRefreshInterval - how often the IP addresses of the destinations outside of the cluster are
+	// resolved again, as a NetworkPolicy cannot match host names
+	EgressRefreshInterval = 10 * time.Minute
+
+	// dnsNamespace - namespace of the cluster DNS pods
+	dnsNamespace = "openshift-dns"
+)
+
+// KnativeIngressNamespaces - namespaces of the Knative activator and of the ingress gateways (Kourier and the
+// Istio gateways of OpenShift AI) Serverless InferenceServices are reached through
+var KnativeIngressNamespaces = []string{"knative-serving", "knative-serving-ingress", "istio-system"}
+
+// OLSAppServerPodLabels - labels of the pods of the OLS app server
+var OLSAppServerPodLabels = map[string]string{
+	"app.kubernetes.io/name": "lightspeed-service-api",
+}
+
+// NetworkPolicyGVK - GroupVersionKind of NetworkPolicies
+var NetworkPolicyGVK = networkingv1.SchemeGroupVersion.WithKind("NetworkPolicy")
+
+// EnsureEgressNetworkPolicy creates or updates the NetworkPolicy allowing the OLS app server egress only to the
+// LLM, the MCP servers, DNS, the API server and the pods of its namespace. The LLM is reached through the
+// InferenceService, see GetInferenceServiceEgressRules, the pods of the Service of an in-cluster endpoint, and
+// through the IP addresses the host name of other endpoints resolves to. Returns the delay after which the IP addresses need to be resolved again, or 0
+// when no host name was resolved.
+func EnsureEgressNetworkPolicy(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (time.Duration, error) {
+	// The Services of the endpoints and the API server live outside of WATCH_NAMESPACE
+	apiServerRule, err := getAPIServerEgressRule(ctx, reader)
+	if err != nil {
+		return 0, err
+	}
+
+	egressRules := []networkingv1.NetworkPolicyEgressRule{
+		GetDNSEgressRule(),
+		apiServerRule,
+		// The conversation cache, the guardrails gateway and the RHOAI MCP server
+		{To: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}},
+	}
+
+	var refreshDelay time.Duration
+	if ref := GetInferenceServiceRef(instance); ref != nil && instance.Spec.Guardrails == nil {
+		inferenceServiceRules, isResolved, err := GetInferenceServiceEgressRules(ctx, helper, reader, instance, ref)
+		if err != nil {
+			return 0, err
+		}
+		egressRules = append(egressRules, inferenceServiceRules...)
+		if isResolved {
+			refreshDelay = EgressRefreshInterval
+		}
+	} else {
+		egressRule, isResolved, err := getURLEgressRule(ctx, reader, GetLLMEndpoint(instance))
+		if err != nil {
+			return 0, err
+		}
+		egressRules = append(egressRules, egressRule)
+		if isResolved {
+			refreshDelay = EgressRefreshInterval
+		}
+	}
+
+	if instance.Spec.Tools != nil {
+		for _, mcpServer := range instance.Spec.Tools.MCPServers {
+			egressRule, isResolved, err := getURLEgressRule(ctx, reader, mcpServer.URL)
+			if err != nil {
+				return 0, fmt.Errorf("MCP server %s: %w", mcpServer.Name, err)
+			}
+			egressRules = append(egressRules, egressRule)
+			if isResolved {
+				refreshDelay = EgressRefreshInterval
+			}
+		}
+	}
+
+	// The requests to the LLM and the MCP servers may go through the cluster-wide proxy
+	if instance.Status.Proxy != nil {
+		for _, proxyURL := range []string{instance.Status.Proxy.HTTPProxy, instance.Status.Proxy.HTTPSProxy} {
+			if proxyURL == "" {
+				continue
+			}
+			egressRule, isResolved, err := getURLEgressRule(ctx, reader, proxyURL)
+			if err != nil {
+				return 0, fmt.Errorf("proxy: %w", err)
+			}
+			egressRules = append(egressRules, egressRule)
+			if isResolved {
+				refreshDelay = EgressRefreshInterval
+			}
+		}
+	}
+
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      EgressNetworkPolicyName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), networkPolicy, func() error {
+		networkPolicy.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: OLSAppServerPodLabels},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      egressRules,
+		}
+
+		return controllerutil.SetControllerReference(instance, networkPolicy, helper.GetScheme())
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return refreshDelay, nil
+}
+
+// RemoveEgressNetworkPolicy deletes the NetworkPolicy created by EnsureEgressNetworkPolicy if it is owned by the
+// instance.
+func RemoveEgressNetworkPolicy(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	_, err := RemoveInstanceOwnedObject(ctx, helper, instance, NetworkPolicyGVK, EgressNetworkPolicyName)
+	return err
+}
+
+// GetInferenceServiceEgressRules returns the egress rules allowing the InferenceService referenced by ref.
+// Serverless InferenceServices are reached through the Knative activator and ingress gateways, which run in
+// KnativeIngressNamespaces, or directly through their pods. Other InferenceServices are reached through the
+// pods behind the Service of their address. Returns true if a host name was resolved.
+func GetInferenceServiceEgressRules(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	ref *apiv1beta1.InferenceServiceReference,
+) ([]networkingv1.NetworkPolicyEgressRule, bool, error) {
+	inferenceService, err := GetInferenceService(ctx, helper, instance, ref)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if GetInferenceServiceDeploymentMode(inferenceService) == InferenceServiceServerless {
+		egressRules := []networkingv1.NetworkPolicyEgressRule{
+			GetPodsEgressRule(GetInferenceServiceNamespace(instance, ref),
+				map[string]string{"serving.kserve.io/inferenceservice": ref.Name}),
+		}
+		for _, namespace := range KnativeIngressNamespaces {
+			egressRules = append(egressRules, GetPodsEgressRule(namespace, nil))
+		}
+
+		return egressRules, false, nil
+	}
+
+	predictorURL, err := GetInferenceServiceURL(inferenceService)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The Services of the InferenceServices live outside of WATCH_NAMESPACE
+	egressRule, isResolved, err := getURLEgressRule(ctx, reader, predictorURL.String())
+	if err != nil {
+		return nil, false, err
+	}
+
+	return []networkingv1.NetworkPolicyEgressRule{egressRule}, isResolved, nil
+}
+
+// GetDNSEgressRule returns the egress rule allowing DNS queries to the cluster DNS pods, which listen on port
+// 5353 behind the port 53 of their Service.
+func GetDNSEgressRule() networkingv1.NetworkPolicyEgressRule {
+	var ports []networkingv1.NetworkPolicyPort
+	for _, port := range []int{53, 5353} {
+		for _, protocol := range []corev1.Protocol{corev1.ProtocolUDP, corev1.ProtocolTCP} {
+			ports = append(ports, networkingv1.NetworkPolicyPort{
+				Protocol: ptr.To(protocol),
+				Port:     ptr.To(intstr.FromInt(port)),
+			})
+		}
+	}
+
+	return networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{corev1.LabelMetadataName: dnsNamespace},
+				},
+			},
+		},
+		Ports: ports,
+	}
+}
+
+// GetPodsEgressRule returns the egress rule allowing any port of the pods matching podLabels in namespace.
+func GetPodsEgressRule(namespace string, podLabels map[string]string) networkingv1.NetworkPolicyEgressRule {
+	return networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{corev1.LabelMetadataName: namespace},
+				},
+				PodSelector: &metav1.LabelSelector{MatchLabels: podLabels},
+			},
+		},
+	}
+}
+
+// GetIPEgressRule returns the egress rule allowing the TCP port of the IP addresses.
+func GetIPEgressRule(ips []net.IP, port int) networkingv1.NetworkPolicyEgressRule {
+	egressRule := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{
+				Protocol: ptr.To(corev1.ProtocolTCP),
+				Port:     ptr.To(intstr.FromInt(port)),
+			},
+		},
+	}
+
+	for _, ip := range ips {
+		prefixLength := 128
+		if ip.To4() != nil {
+			prefixLength = 32
+		}
+
+		egressRule.To = append(egressRule.To, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: fmt.Sprintf("%s/%d", ip.String(), prefixLength)},
+		})
+	}
+
+	return egressRule
+}
+
+// getAPIServerEgressRule returns the egress rule allowing the endpoints of the kubernetes Service. The API
+// servers run in the host network, so they are matched by their IP addresses.
+func getAPIServerEgressRule(ctx context.Context, reader client.Reader) (networkingv1.NetworkPolicyEgressRule, error) {
+	var endpointSlices discoveryv1.EndpointSliceList
+	err := reader.List(ctx, &endpointSlices, client.InNamespace(metav1.NamespaceDefault),
+		client.MatchingLabels{discoveryv1.LabelServiceName: "kubernetes"})
+	if err != nil {
+		return networkingv1.NetworkPolicyEgressRule{}, err
+	}
+
+	var ips []net.IP
+	port := 6443
+	for _, endpointSlice := range endpointSlices.Items {
+		for _, endpointPort := range endpointSlice.Ports {
+			if endpointPort.Port != nil {
+				port = int(*endpointPort.Port)
+			}
+		}
+
+		for _, endpoint := range endpointSlice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				if ip := net.ParseIP(address); ip != nil {
+					ips = append(ips, ip)
+				}
+			}
+		}
+	}
+
+	if len(ips) == 0 {
+		return networkingv1.NetworkPolicyEgressRule{}, fmt.Errorf("the kubernetes Service has no endpoints")
+	}
+
+	return GetIPEgressRule(ips, port), nil
+}
+
+// getURLEgressRule returns the egress rule allowing the destination of rawURL: the pods behind the Service of an
+// in-cluster URL, or the port of the IP addresses of other URLs. Returns true if a host name was resolved.
+func getURLEgressRule(
+	ctx context.Context,
+	reader client.Reader,
+	rawURL string,
+) (networkingv1.NetworkPolicyEgressRule, bool, error) {
+	host, port, err := GetURLHostPort(rawURL)
+	if err != nil {
+		return networkingv1.NetworkPolicyEgressRule{}, false, err
+	}
+
+	if name, namespace, isService := GetInClusterService(host); isService {
+		service := &corev1.Service{}
+		err = reader.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, service)
+		if err != nil {
+			return networkingv1.NetworkPolicyEgressRule{}, false, err
+		}
+
+		if len(service.Spec.Selector) == 0 {
+			return networkingv1.NetworkPolicyEgressRule{}, false,
+				fmt.Errorf("Service %s/%s has no pod selector", namespace, name)
+		}
+
+		return GetPodsEgressRule(namespace, service.Spec.Selector), false, nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return GetIPEgressRule([]net.IP{ip}, port), false, nil
+	}
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return networkingv1.NetworkPolicyEgressRule{}, false, err
+	}
+
+	ips := make([]net.IP, 0, len(addresses))
+	for _, address := range addresses {
+		ips = append(ips, address.IP)
+	}
+
+	return GetIPEgressRule(ips, port), true, nil
+}
+
+// GetURLHostPort returns the host and the port of rawURL, defaulting the port from the scheme.
+func GetURLHostPort(rawURL string) (string, int, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return "", 0, err
+	}
+
+	host := parsedURL.Hostname()
+	if host == "" {
+		return "", 0, fmt.Errorf("URL %q has no host", rawURL)
+	}
+
+	if parsedURL.Port() != "" {
+		port, err := strconv.Atoi(parsedURL.Port())
+		if err != nil {
+			return "", 0, fmt.Errorf("URL %q has an invalid port", rawURL)
+		}
+		return host, port, nil
+	}
+
+	if parsedURL.Scheme == "http" {
+		return host, 80, nil
+	}
+
+	return host, 443, nil
+}
+
+// GetInClusterService returns the name and the namespace of the Service of an in-cluster host name, e.g.
+// my-service.my-namespace.svc or my-service.my-namespace.svc.cluster.local.
+func GetInClusterService(host string) (string, string, bool) {
+	host = strings.TrimSuffix(host, ".cluster.local")
+	labels := strings.Split(host, ".")
+	if len(labels) != 3 || labels[2] != "svc" {
+		return "", "", false
+	}
+
+	return labels[0], labels[1], true
+}
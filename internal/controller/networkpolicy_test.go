@@ -0,0 +1,155 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("Egress NetworkPolicy", func() {
+	It("should default the port of the URLs from the scheme", func() {
+		host, port, err := GetURLHostPort("https://llm.example.com/v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(host).To(Equal("llm.example.com"))
+		Expect(port).To(Equal(443))
+
+		_, port, err = GetURLHostPort("http://llm.example.com/v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(port).To(Equal(80))
+
+		_, port, err = GetURLHostPort("https://llm.example.com:8443/v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(port).To(Equal(8443))
+
+		_, _, err = GetURLHostPort("/v1")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should recognize in-cluster Service host names", func() {
+		name, namespace, isService := GetInClusterService("llm-predictor.models.svc")
+		Expect(isService).To(BeTrue())
+		Expect(name).To(Equal("llm-predictor"))
+		Expect(namespace).To(Equal("models"))
+
+		name, namespace, isService = GetInClusterService("llm-predictor.models.svc.cluster.local")
+		Expect(isService).To(BeTrue())
+		Expect(name).To(Equal("llm-predictor"))
+		Expect(namespace).To(Equal("models"))
+
+		_, _, isService = GetInClusterService("llm.example.com")
+		Expect(isService).To(BeFalse())
+	})
+
+	It("should allow the port of the IP addresses", func() {
+		egressRule := GetIPEgressRule([]net.IP{net.ParseIP("192.0.2.10"), net.ParseIP("2001:db8::10")}, 443)
+
+		Expect(egressRule.To).To(HaveLen(2))
+		Expect(egressRule.To[0].IPBlock.CIDR).To(Equal("192.0.2.10/32"))
+		Expect(egressRule.To[1].IPBlock.CIDR).To(Equal("2001:db8::10/128"))
+		Expect(egressRule.Ports).To(HaveLen(1))
+		Expect(*egressRule.Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+		Expect(*egressRule.Ports[0].Port).To(Equal(intstr.FromInt(443)))
+	})
+
+	It("should allow DNS queries to the cluster DNS pods", func() {
+		egressRule := GetDNSEgressRule()
+
+		Expect(egressRule.To).To(HaveLen(1))
+		Expect(egressRule.To[0].NamespaceSelector.MatchLabels).To(
+			HaveKeyWithValue(corev1.LabelMetadataName, "openshift-dns"))
+		Expect(egressRule.Ports).To(HaveLen(4))
+	})
+
+	Context("with an InferenceService", func() {
+		var instance *apiv1beta1.OpenShiftAILightspeed
+
+		BeforeEach(func() {
+			instance = &apiv1beta1.OpenShiftAILightspeed{}
+			instance.Name = "openshift-ai-lightspeed"
+			instance.Namespace = "openshift-lightspeed"
+			instance.Spec.InferenceServiceRef = &apiv1beta1.InferenceServiceReference{Name: "granite", Namespace: "models"}
+		})
+
+		It("should prefer the deployment mode reported in the status", func() {
+			inferenceService := newInferenceService("granite", "models", map[string]interface{}{})
+			Expect(GetInferenceServiceDeploymentMode(inferenceService)).To(BeEmpty())
+
+			inferenceService.SetAnnotations(map[string]string{
+				InferenceServiceDeploymentModeAnnotation: InferenceServiceServerless,
+			})
+			Expect(GetInferenceServiceDeploymentMode(inferenceService)).To(Equal(InferenceServiceServerless))
+
+			inferenceService.Object["status"] = map[string]interface{}{"deploymentMode": InferenceServiceRawDeployment}
+			Expect(GetInferenceServiceDeploymentMode(inferenceService)).To(Equal(InferenceServiceRawDeployment))
+		})
+
+		It("should allow the Knative ingress of a Serverless InferenceService", func() {
+			inferenceService := newInferenceService("granite", "models", map[string]interface{}{
+				"address":        map[string]interface{}{"url": "http://granite-predictor.models.svc.cluster.local"},
+				"deploymentMode": InferenceServiceServerless,
+			})
+			helper := newInferenceServiceHelper(instance, inferenceService)
+
+			egressRules, isResolved, err := GetInferenceServiceEgressRules(context.Background(), helper,
+				helper.GetClient(), instance, instance.Spec.InferenceServiceRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isResolved).To(BeFalse())
+			Expect(egressRules).To(HaveLen(1 + len(KnativeIngressNamespaces)))
+			Expect(egressRules[0]).To(Equal(GetPodsEgressRule("models",
+				map[string]string{"serving.kserve.io/inferenceservice": "granite"})))
+			for i, namespace := range KnativeIngressNamespaces {
+				Expect(egressRules[1+i].To[0].NamespaceSelector.MatchLabels).To(
+					HaveKeyWithValue(corev1.LabelMetadataName, namespace))
+				Expect(egressRules[1+i].To[0].PodSelector.MatchLabels).To(BeEmpty())
+			}
+		})
+
+		It("should allow the pods behind the Service of a RawDeployment InferenceService", func() {
+			inferenceService := newInferenceService("granite", "models", map[string]interface{}{
+				"address": map[string]interface{}{"url": "https://granite-predictor.models.svc.cluster.local"},
+			})
+			inferenceService.SetAnnotations(map[string]string{
+				InferenceServiceDeploymentModeAnnotation: InferenceServiceRawDeployment,
+			})
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "granite-predictor", Namespace: "models"},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "isvc.granite-predictor"},
+				},
+			}
+			helper := newInferenceServiceHelper(instance, inferenceService, service)
+
+			egressRules, isResolved, err := GetInferenceServiceEgressRules(context.Background(), helper,
+				helper.GetClient(), instance, instance.Spec.InferenceServiceRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isResolved).To(BeFalse())
+			Expect(egressRules).To(Equal([]networkingv1.NetworkPolicyEgressRule{
+				GetPodsEgressRule("models", map[string]string{"app": "isvc.granite-predictor"}),
+			}))
+		})
+	})
+})
@@ -0,0 +1,282 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the deployment settings of the OLS app server and its conversation cache.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConversationCacheTypePostgres - type of the OLS conversation cache configured by the operator
+	ConversationCacheTypePostgres = "postgres"
+
+	// storageClassQuotaSuffix - suffix of the ResourceQuota resources limiting the storage requested per
+	// StorageClass
+	storageClassQuotaSuffix = ".storageclass.storage.k8s.io/requests.storage"
+)
+
+// PatchOLSDeployment sets the deployment, storage and conversation cache settings of the OLSConfig from
+// OLSServer and ConversationCache. Settings that are not set are removed, so the OLS operator defaults apply.
+func PatchOLSDeployment(instance *apiv1beta1.OpenShiftAILightspeed, olsConfig *uns.Unstructured) error {
+	server := instance.Spec.OLSServer
+	if server == nil {
+		server = &apiv1beta1.OLSServerSpec{}
+	}
+
+	cache := instance.Spec.ConversationCache
+	if cache == nil {
+		cache = &apiv1beta1.ConversationCacheSpec{}
+	}
+
+	if server.Replicas != nil {
+		err := uns.SetNestedField(olsConfig.Object, int64(*server.Replicas), "spec", "ols", "deployment", "replicas")
+		if err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "deployment", "replicas")
+	}
+
+	err := patchOLSContainerConfig(olsConfig, server.Resources, server.NodeSelector, server.Tolerations, "api")
+	if err != nil {
+		return err
+	}
+
+	err = patchOLSContainerConfig(olsConfig, cache.Resources, cache.NodeSelector, cache.Tolerations, "database")
+	if err != nil {
+		return err
+	}
+
+	if deployment, found, _ := uns.NestedMap(olsConfig.Object, "spec", "ols", "deployment"); found && len(deployment) == 0 {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "deployment")
+	}
+
+	storage := map[string]interface{}{}
+	if cache.StorageSize != nil {
+		storage["size"] = cache.StorageSize.String()
+	}
+	if cache.StorageClass != "" {
+		storage["class"] = cache.StorageClass
+	}
+	if len(storage) > 0 {
+		if err := uns.SetNestedMap(olsConfig.Object, storage, "spec", "ols", "storage"); err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "storage")
+	}
+
+	postgres := map[string]interface{}{}
+	if cache.MaxConnections != nil {
+		postgres["maxConnections"] = int64(*cache.MaxConnections)
+	}
+	if cache.SharedBuffers != "" {
+		postgres["sharedBuffers"] = cache.SharedBuffers
+	}
+	if len(postgres) > 0 {
+		conversationCache := map[string]interface{}{
+			"type":     ConversationCacheTypePostgres,
+			"postgres": postgres,
+		}
+		if err := uns.SetNestedMap(olsConfig.Object, conversationCache, "spec", "ols", "conversationCache"); err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "conversationCache")
+	}
+
+	return nil
+}
+
+// patchOLSContainerConfig sets the resources, the node selector and the tolerations of a container in the
+// deployment section of the OLSConfig, or removes the container section when none of them is set.
+func patchOLSContainerConfig(
+	olsConfig *uns.Unstructured,
+	resources *corev1.ResourceRequirements,
+	nodeSelector map[string]string,
+	tolerations []corev1.Toleration,
+	container string,
+) error {
+	containerConfig := map[string]interface{}{}
+
+	if resources != nil {
+		resourcesMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resources)
+		if err != nil {
+			return err
+		}
+		containerConfig["resources"] = resourcesMap
+	}
+
+	if len(nodeSelector) > 0 {
+		nodeSelectorMap := map[string]interface{}{}
+		for key, value := range nodeSelector {
+			nodeSelectorMap[key] = value
+		}
+		containerConfig["nodeSelector"] = nodeSelectorMap
+	}
+
+	if len(tolerations) > 0 {
+		tolerationsSlice := make([]interface{}, 0, len(tolerations))
+		for i := range tolerations {
+			toleration, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tolerations[i])
+			if err != nil {
+				return err
+			}
+			tolerationsSlice = append(tolerationsSlice, toleration)
+		}
+		containerConfig["tolerations"] = tolerationsSlice
+	}
+
+	if len(containerConfig) == 0 {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "deployment", container)
+		return nil
+	}
+
+	return uns.SetNestedMap(olsConfig.Object, containerConfig, "spec", "ols", "deployment", container)
+}
+
+// GetOLSResourceQuotaViolation returns a message describing the resources requested by the OLS app server
+// and the conversation cache that exceed the ResourceQuotas of the namespace of the instance, or an empty
+// string when they fit. The resources are only checked when OLSServer or ConversationCache is set.
+func GetOLSResourceQuotaViolation(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (string, error) {
+	if instance.Spec.OLSServer == nil && instance.Spec.ConversationCache == nil {
+		return "", nil
+	}
+
+	var quotas corev1.ResourceQuotaList
+	err := helper.GetClient().List(ctx, &quotas, client.InNamespace(instance.Namespace))
+	if err != nil {
+		return "", err
+	}
+
+	violations := GetResourceQuotaViolations(GetOLSResourceRequirements(instance), quotas.Items)
+	return strings.Join(violations, "; "), nil
+}
+
+// GetOLSResourceRequirements returns the resources requested by the OLS app server replicas and the
+// conversation cache in the format of the ResourceQuota resources. Only the resources set in OLSServer and
+// ConversationCache are counted.
+func GetOLSResourceRequirements(instance *apiv1beta1.OpenShiftAILightspeed) corev1.ResourceList {
+	requirements := corev1.ResourceList{}
+
+	if server := instance.Spec.OLSServer; server != nil && server.Resources != nil {
+		replicas := int32(1)
+		if server.Replicas != nil {
+			replicas = *server.Replicas
+		}
+		addContainerResourceRequirements(requirements, server.Resources, replicas)
+	}
+
+	if cache := instance.Spec.ConversationCache; cache != nil {
+		if cache.Resources != nil {
+			addContainerResourceRequirements(requirements, cache.Resources, 1)
+		}
+
+		if cache.StorageSize != nil {
+			addResourceQuantity(requirements, corev1.ResourceRequestsStorage, *cache.StorageSize, 1)
+			if cache.StorageClass != "" {
+				addResourceQuantity(requirements, corev1.ResourceName(cache.StorageClass+storageClassQuotaSuffix),
+					*cache.StorageSize, 1)
+			}
+		}
+	}
+
+	return requirements
+}
+
+// addContainerResourceRequirements adds the CPU and memory requests and limits of replicas of a container to
+// requirements. Like in Kubernetes, the limit is used as the request when only the limit is set.
+func addContainerResourceRequirements(
+	requirements corev1.ResourceList,
+	resources *corev1.ResourceRequirements,
+	replicas int32,
+) {
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request, hasRequest := resources.Requests[name]
+		limit, hasLimit := resources.Limits[name]
+		if !hasRequest && hasLimit {
+			request, hasRequest = limit, true
+		}
+
+		if hasRequest {
+			// Quotas limit the requests either with or without the requests. prefix
+			addResourceQuantity(requirements, name, request, replicas)
+			addResourceQuantity(requirements, corev1.ResourceName("requests."+string(name)), request, replicas)
+		}
+
+		if hasLimit {
+			addResourceQuantity(requirements, corev1.ResourceName("limits."+string(name)), limit, replicas)
+		}
+	}
+}
+
+// addResourceQuantity adds quantity times count to the resource name of requirements.
+func addResourceQuantity(requirements corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity, count int32) {
+	total := requirements[name]
+	for range count {
+		total.Add(quantity)
+	}
+	requirements[name] = total
+}
+
+// GetResourceQuotaViolations returns a message for each resource of requirements that exceeds the hard limit
+// of a ResourceQuota. ResourceQuotas with scopes are skipped, as the OLS pods may not be in their scope.
+func GetResourceQuotaViolations(requirements corev1.ResourceList, quotas []corev1.ResourceQuota) []string {
+	names := make([]corev1.ResourceName, 0, len(requirements))
+	for name := range requirements {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var violations []string
+	for _, quota := range quotas {
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+
+		hard := quota.Status.Hard
+		if hard == nil {
+			hard = quota.Spec.Hard
+		}
+
+		for _, name := range names {
+			quantity := requirements[name]
+			if limit, found := hard[name]; found && quantity.Cmp(limit) > 0 {
+				violations = append(violations, fmt.Sprintf("%s %s exceeds the hard limit %s of ResourceQuota %s",
+					name, quantity.String(), limit.String(), quota.Name))
+			}
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("OLS deployment", func() {
+	newInstance := func() *apiv1beta1.OpenShiftAILightspeed {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.OLSServer = &apiv1beta1.OLSServerSpec{
+			Replicas: ptr.To(int32(2)),
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+		}
+		instance.Spec.ConversationCache = &apiv1beta1.ConversationCacheSpec{
+			StorageSize:    ptr.To(resource.MustParse("5Gi")),
+			StorageClass:   "gp3-csi",
+			MaxConnections: ptr.To(int32(200)),
+		}
+		return instance
+	}
+
+	It("should count the resources of all the OLS server replicas", func() {
+		requirements := GetOLSResourceRequirements(newInstance())
+
+		Expect(requirements.Name("requests.cpu", resource.DecimalSI).String()).To(Equal("1"))
+		// The memory limit is used as the request
+		Expect(requirements.Name("requests.memory", resource.BinarySI).String()).To(Equal("2Gi"))
+		Expect(requirements.Name("limits.memory", resource.BinarySI).String()).To(Equal("2Gi"))
+		Expect(requirements).NotTo(HaveKey(corev1.ResourceName("limits.cpu")))
+		Expect(requirements.Name(corev1.ResourceRequestsStorage, resource.BinarySI).String()).To(Equal("5Gi"))
+		Expect(requirements).To(HaveKey(corev1.ResourceName("gp3-csi" + storageClassQuotaSuffix)))
+	})
+
+	It("should report the resources exceeding unscoped quotas", func() {
+		requirements := GetOLSResourceRequirements(newInstance())
+		quotas := []corev1.ResourceQuota{
+			{
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("500m")},
+				},
+			},
+			{
+				Spec: corev1.ResourceQuotaSpec{
+					Hard:   corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("1Gi")},
+					Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort},
+				},
+			},
+			{
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("10Gi")},
+				},
+			},
+		}
+		quotas[0].Name = "compute"
+		quotas[1].Name = "best-effort"
+		quotas[2].Name = "storage"
+
+		violations := GetResourceQuotaViolations(requirements, quotas)
+		Expect(violations).To(HaveLen(1))
+		Expect(violations[0]).To(ContainSubstring("requests.cpu 1 exceeds the hard limit 500m of ResourceQuota compute"))
+	})
+
+	It("should set and remove the deployment settings of the OLSConfig", func() {
+		instance := newInstance()
+		olsConfig := &uns.Unstructured{Object: map[string]interface{}{}}
+		Expect(PatchOLSDeployment(instance, olsConfig)).To(Succeed())
+
+		replicas, _, _ := uns.NestedInt64(olsConfig.Object, "spec", "ols", "deployment", "replicas")
+		Expect(replicas).To(Equal(int64(2)))
+		cpu, _, _ := uns.NestedString(olsConfig.Object,
+			"spec", "ols", "deployment", "api", "resources", "requests", "cpu")
+		Expect(cpu).To(Equal("500m"))
+		size, _, _ := uns.NestedString(olsConfig.Object, "spec", "ols", "storage", "size")
+		Expect(size).To(Equal("5Gi"))
+		cacheType, _, _ := uns.NestedString(olsConfig.Object, "spec", "ols", "conversationCache", "type")
+		Expect(cacheType).To(Equal(ConversationCacheTypePostgres))
+		_, found, _ := uns.NestedMap(olsConfig.Object, "spec", "ols", "deployment", "database")
+		Expect(found).To(BeFalse())
+
+		instance.Spec.OLSServer = nil
+		instance.Spec.ConversationCache = nil
+		Expect(PatchOLSDeployment(instance, olsConfig)).To(Succeed())
+		_, found, _ = uns.NestedMap(olsConfig.Object, "spec", "ols", "deployment")
+		Expect(found).To(BeFalse())
+		_, found, _ = uns.NestedMap(olsConfig.Object, "spec", "ols", "storage")
+		Expect(found).To(BeFalse())
+		_, found, _ = uns.NestedMap(olsConfig.Object, "spec", "ols", "conversationCache")
+		Expect(found).To(BeFalse())
+	})
+})
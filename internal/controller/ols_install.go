@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
@@ -30,6 +31,8 @@ import (
 
 	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -72,7 +75,8 @@ func EnsureOLSOperatorInstalled(
 // InstallInstanceOwnedOLSOperator - ensures that the OpenShift Lightspeed Operator (OLS Operator)
 // is installed and owned by the specified OpenShiftAILightspeed instance. This function:
 //  1. Determines the recommended OLS Operator version.
-//  2. Creates or updates a Subscription, setting the instance as its owner.
+//  2. Creates or updates a Subscription, setting the instance as its owner and passing the
+//     cluster-wide proxy settings and the OLSOperator pod settings to the OLS Operator.
 //  3. Approves the related InstallPlan manually.
 //  4. Sets ownership of the generated ClusterServiceVersion (CSV) to the instance.
 //  5. Returns true if the OLS Operator is installed and owned by the instance, or an error otherwise.
@@ -88,6 +92,11 @@ func InstallInstanceOwnedOLSOperator(
 		},
 	}
 
+	proxy, err := GetClusterProxy(ctx, helper)
+	if err != nil {
+		return false, err
+	}
+
 	instanceOwnerReference := []metav1.OwnerReference{
 		{
 			APIVersion:         instance.APIVersion,
@@ -105,6 +114,7 @@ func InstallInstanceOwnedOLSOperator(
 			CatalogSource:          instance.Spec.CatalogSourceName,
 			CatalogSourceNamespace: instance.Spec.CatalogSourceNamespace,
 			Package:                OLSOperatorName,
+			Config:                 GetOLSSubscriptionConfig(instance, proxy),
 		}
 
 		err := SetStartingCSV(subscription)
@@ -425,3 +435,35 @@ func SetStartingCSV(subscription *operatorsv1alpha1.Subscription) error {
 
 	return nil
 }
+
+// GetOLSSubscriptionConfig returns the config of the OLS operator Subscription, which passes the cluster-wide
+// proxy settings and the OLSOperator pod settings to the OLS operator. The environment variables of
+// OLSOperator take precedence over the proxy ones. Returns nil when there is nothing to configure.
+func GetOLSSubscriptionConfig(
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	proxy *ClusterProxy,
+) *operatorsv1alpha1.SubscriptionConfig {
+	config := &operatorsv1alpha1.SubscriptionConfig{}
+
+	olsOperator := instance.Spec.OLSOperator
+	if olsOperator != nil {
+		config.NodeSelector = olsOperator.NodeSelector
+		config.Tolerations = olsOperator.Tolerations
+		config.Resources = olsOperator.Resources
+		config.Volumes = olsOperator.Volumes
+		config.VolumeMounts = olsOperator.VolumeMounts
+		config.Env = slices.Clone(olsOperator.Env)
+	}
+
+	for _, proxyEnv := range GetProxyEnv(proxy) {
+		if !slices.ContainsFunc(config.Env, func(env corev1.EnvVar) bool { return env.Name == proxyEnv.Name }) {
+			config.Env = append(config.Env, proxyEnv)
+		}
+	}
+
+	if equality.Semantic.DeepEqual(config, &operatorsv1alpha1.SubscriptionConfig{}) {
+		return nil
+	}
+
+	return config
+}
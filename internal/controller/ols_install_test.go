@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("OLS operator Subscription config", func() {
+	It("should render the OLS operator pod settings", func() {
+		resources := &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		}
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.OLSOperator = &apiv1beta1.OLSOperatorSpec{
+			Resources:    resources,
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			Tolerations: []corev1.Toleration{
+				{Key: "node-role.kubernetes.io/infra", Effect: corev1.TaintEffectNoSchedule},
+			},
+			Volumes: []corev1.Volume{
+				{Name: "certs", VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "certs"},
+					},
+				}},
+			},
+			VolumeMounts: []corev1.VolumeMount{{Name: "certs", MountPath: "/etc/certs"}},
+		}
+
+		config := GetOLSSubscriptionConfig(instance, nil)
+		Expect(config).NotTo(BeNil())
+		Expect(config.Resources).To(Equal(resources))
+		Expect(config.NodeSelector).To(HaveKey("node-role.kubernetes.io/infra"))
+		Expect(config.Tolerations).To(HaveLen(1))
+		Expect(config.Volumes).To(HaveLen(1))
+		Expect(config.VolumeMounts).To(HaveLen(1))
+		Expect(config.Env).To(BeEmpty())
+	})
+
+	It("should give precedence to the environment variables of the spec over the proxy ones", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.OLSOperator = &apiv1beta1.OLSOperatorSpec{
+			Env: []corev1.EnvVar{
+				{Name: "NO_PROXY", Value: ".example.com"},
+				{Name: "LOG_LEVEL", Value: "debug"},
+			},
+		}
+
+		config := GetOLSSubscriptionConfig(instance, &ClusterProxy{
+			HTTPProxy: "http://proxy.example.com:3128",
+			NoProxy:   ".cluster.local",
+		})
+		Expect(config.Env).To(Equal([]corev1.EnvVar{
+			{Name: "NO_PROXY", Value: ".example.com"},
+			{Name: "LOG_LEVEL", Value: "debug"},
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+		}))
+		Expect(instance.Spec.OLSOperator.Env).To(HaveLen(2))
+	})
+})
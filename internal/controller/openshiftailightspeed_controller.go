@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,7 +26,14 @@ import (
 	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
 	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -34,6 +42,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -46,6 +55,8 @@ type OpenShiftAILightspeedReconciler struct {
 	client.Client
 	Scheme  *runtime.Scheme
 	Kclient kubernetes.Interface
+	// APIReader reads the objects outside of WATCH_NAMESPACE, which are not in the cache of the client
+	APIReader client.Reader
 }
 
 // GetLogger returns a logger object with a prefix of "controller.name" and additional controller context fields
@@ -63,6 +74,41 @@ func (r *OpenShiftAILightspeedReconciler) GetLogger(ctx context.Context) logr.Lo
 // +kubebuilder:rbac:groups=operators.coreos.com,resources=clusterserviceversions,namespace=openshift-lightspeed,verbs=update;patch;delete
 // +kubebuilder:rbac:groups=operators.coreos.com,resources=subscriptions,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operators.coreos.com,resources=installplans,namespace=openshift-lightspeed,verbs=get;list;watch;update;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,namespace=openshift-lightspeed,verbs=create
+// +kubebuilder:rbac:groups="",resources=secrets,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,namespace=openshift-lightspeed,verbs=create;update;patch;delete
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods,namespace=openshift-lightspeed,verbs=get;list;watch
+// +kubebuilder:rbac:groups=datasciencecluster.opendatahub.io,resources=datascienceclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=llamastack.io,resources=llamastackdistributions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=trustyai.opendatahub.io,resources=guardrailsorchestrators,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=build.openshift.io,resources=buildconfigs,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=build.openshift.io,resources=buildconfigs/instantiatebinary,namespace=openshift-lightspeed,verbs=create
+// +kubebuilder:rbac:groups=build.openshift.io,resources=builds,namespace=openshift-lightspeed,verbs=get;list;watch
+// +kubebuilder:rbac:groups=build.openshift.io,resources=builds/log,namespace=openshift-lightspeed,verbs=get
+// +kubebuilder:rbac:groups=lightspeed.openshift-ai.io,resources=lightspeedragcontributions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=lightspeed.openshift-ai.io,resources=lightspeedragcontributions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=resourcequotas,namespace=openshift-lightspeed,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames=lightspeed-operator-query-access,verbs=bind
+// +kubebuilder:rbac:groups=kubeflow.org,resources=notebooks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=services,verbs=get
+// +kubebuilder:rbac:groups=datasciencepipelinesapplications.opendatahub.io,resources=datasciencepipelinesapplications,verbs=get;list;watch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams,namespace=openshift-lightspeed,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=image.openshift.io,resources=imagestreamimports,namespace=openshift-lightspeed,verbs=create
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -139,6 +185,14 @@ func (r *OpenShiftAILightspeedReconciler) Reconcile(ctx context.Context, req ctr
 	instance.Status.Conditions.Init(&cl)
 	instance.Status.ObservedGeneration = instance.Generation
 
+	// Nothing is changed while paused, not even on deletion, e.g. to let the OLSConfig be edited by hand
+	if IsPaused(instance) {
+		Log.Info("OpenShiftAILightspeed reconciliation is paused")
+		instance.Status.Conditions.MarkTrue(apiv1beta1.PausedCondition, apiv1beta1.PausedMessage)
+		return ctrl.Result{}, nil
+	}
+	instance.Status.Conditions.Remove(apiv1beta1.PausedCondition)
+
 	if !instance.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, helper, instance)
 	}
@@ -147,14 +201,52 @@ func (r *OpenShiftAILightspeedReconciler) Reconcile(ctx context.Context, req ctr
 		return ctrl.Result{}, nil
 	}
 
-	if instance.Spec.RAGImage == "" {
-		instance.Spec.RAGImage = apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGImageURL
+	// With a RAG source the RAG image is the one the content is staged into
+	if instance.Spec.RAGImage == "" && instance.Spec.RAGSource == nil {
+		ragImage, err := SelectRAGImage(ctx, helper, r.APIReader, instance)
+		if err != nil {
+			Log.Info("Could not select the RAG image for the installed OpenShift AI version", "error", err.Error())
+			ragImage = apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGImageURL
+		}
+		instance.Spec.RAGImage = ragImage
+	} else {
+		instance.Status.RAGDocsVersion = ""
 	}
 
 	if instance.Spec.MaxTokensForResponse == 0 {
 		instance.Spec.MaxTokensForResponse = apiv1beta1.OpenShiftAILightspeedDefaultValues.MaxTokensForResponse
 	}
 
+	err = ValidateOpenShiftAILightspeed(instance)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.OpenShiftAILightspeedReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityError,
+			apiv1beta1.OpenShiftAILightspeedInvalidSpecMessage,
+			err.Error(),
+		))
+
+		// Retrying won't help until the user fixes the spec, which triggers a new reconciliation.
+		return ctrl.Result{}, nil
+	}
+
+	quotaViolation, err := GetOLSResourceQuotaViolation(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if quotaViolation != "" {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.OpenShiftAILightspeedReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityError,
+			apiv1beta1.OpenShiftAILightspeedResourceQuotaMessage,
+			quotaViolation,
+		))
+
+		// The ResourceQuotas are watched, so raising them triggers a new reconciliation.
+		return ctrl.Result{}, nil
+	}
+
 	// Ensure a compatible version of the OpenShift Lightspeed Operator is running in the cluster.
 	// This checks if the correct OLS Operator version is present and installs it if necessary.
 	isOLSOperatorInstalled, err := EnsureOLSOperatorInstalled(ctx, helper, instance)
@@ -186,6 +278,600 @@ func (r *OpenShiftAILightspeedReconciler) Reconcile(ctx context.Context, req ctr
 		apiv1beta1.OpenShiftLightspeedOperatorReady,
 	)
 
+	// requeueAfter holds the shortest delay after which one of the steps below needs to be
+	// re-evaluated even if nothing changes in the cluster (e.g. token rotation).
+	var requeueAfter time.Duration
+
+	if instance.Spec.ManagedModel != nil {
+		err = EnsureManagedModel(ctx, helper, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.ManagedModelReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.ManagedModelErrorMessage,
+				err.Error(),
+			))
+			return ctrl.Result{}, err
+		}
+
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.ManagedModelReadyCondition,
+			apiv1beta1.ManagedModelReadyMessage,
+		)
+	} else {
+		_, err = RemoveManagedModel(ctx, helper, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		instance.Status.Conditions.Remove(apiv1beta1.ManagedModelReadyCondition)
+	}
+
+	if GetInferenceServiceRef(instance) != nil {
+		isInferenceServiceReady, message, err := ResolveInferenceService(ctx, helper, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.InferenceServiceReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.InferenceServiceErrorMessage,
+				err.Error(),
+			))
+
+			// The InferenceService may not have been created yet
+			if k8s_errors.IsNotFound(err) {
+				return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+			}
+			return ctrl.Result{}, err
+		} else if !isInferenceServiceReady {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.InferenceServiceReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				apiv1beta1.InferenceServiceWaitingMessage,
+				message,
+			))
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.InferenceServiceReadyCondition,
+			apiv1beta1.InferenceServiceReadyMessage,
+		)
+	} else {
+		instance.Status.InferenceService = nil
+		instance.Status.Conditions.Remove(apiv1beta1.InferenceServiceReadyCondition)
+	}
+
+	if instance.Spec.LlamaStackDistributionRef != nil {
+		isLlamaStackReady, message, err := ResolveLlamaStackDistribution(ctx, helper, instance)
+		var modelNotServedErr *ModelNotServedError
+		if errors.As(err, &modelNotServedErr) {
+			// The model set in the spec is not served, the LlamaStackDistribution is watched for changes
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.LlamaStackReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityError,
+				apiv1beta1.LlamaStackErrorMessage,
+				err.Error(),
+			))
+			return ctrl.Result{}, nil
+		} else if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.LlamaStackReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.LlamaStackErrorMessage,
+				err.Error(),
+			))
+
+			// The LlamaStackDistribution may not have been created yet or its server may not be reachable yet
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		} else if !isLlamaStackReady {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.LlamaStackReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				apiv1beta1.LlamaStackWaitingMessage,
+				message,
+			))
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.LlamaStackReadyCondition,
+			apiv1beta1.LlamaStackReadyMessage,
+		)
+	} else {
+		instance.Status.LlamaStack = nil
+		instance.Status.Conditions.Remove(apiv1beta1.LlamaStackReadyCondition)
+	}
+
+	if GetServiceAccountAuthInferenceService(instance) != nil {
+		tokenRotationDelay, err := EnsureServiceAccountToken(ctx, helper, r.APIReader, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.ServiceAccountTokenReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.ServiceAccountTokenErrorMessage,
+				err.Error(),
+			))
+			return ctrl.Result{}, err
+		}
+
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.ServiceAccountTokenReadyCondition,
+			apiv1beta1.ServiceAccountTokenReadyMessage,
+		)
+		requeueAfter = tokenRotationDelay
+	} else {
+		err = RemoveInferenceServiceAccess(ctx, helper, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		instance.Status.Conditions.Remove(apiv1beta1.ServiceAccountTokenReadyCondition)
+	}
+
+	clientCertificateUnsupportedReason := ""
+	if instance.Spec.LLMClientCertificate != "" {
+		clientCertificateUnsupportedReason, err = GetClientCertificateUnsupportedReason(ctx, helper)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if clientCertificateUnsupportedReason != "" {
+		instance.Status.LLMClientCertificateNotAfter = nil
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.LLMClientCertificateReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			apiv1beta1.LLMClientCertificateUnsupportedMessage,
+			clientCertificateUnsupportedReason,
+		))
+
+		// The client certificate is not rendered into the OLSConfig of OLS versions not supporting it
+		instance.Spec.LLMClientCertificate = ""
+	} else if instance.Spec.LLMClientCertificate != "" {
+		certificate, err := GetLLMClientCertificate(ctx, helper, instance, time.Now())
+		if err != nil {
+			instance.Status.LLMClientCertificateNotAfter = nil
+
+			var invalidErr *InvalidClientCertificateError
+			if errors.As(err, &invalidErr) {
+				instance.Status.Conditions.Set(condition.FalseCondition(
+					apiv1beta1.LLMClientCertificateReadyCondition,
+					condition.ErrorReason,
+					condition.SeverityError,
+					apiv1beta1.LLMClientCertificateErrorMessage,
+					err.Error(),
+				))
+
+				// Retrying won't help until the secret is fixed, which triggers a new reconciliation.
+				return ctrl.Result{}, nil
+			}
+
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.LLMClientCertificateReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.LLMClientCertificateErrorMessage,
+				err.Error(),
+			))
+
+			// The secret may not have been created yet
+			if k8s_errors.IsNotFound(err) {
+				return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+			}
+			return ctrl.Result{}, err
+		}
+
+		instance.Status.LLMClientCertificateNotAfter = &metav1.Time{Time: certificate.NotAfter}
+		notAfter := certificate.NotAfter.UTC().Format(time.RFC3339)
+		if IsClientCertificateExpiring(certificate, time.Now()) {
+			// The certificate is still presented to the LLM provider until it expires
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.LLMClientCertificateReadyCondition,
+				condition.ReadyReason,
+				condition.SeverityWarning,
+				apiv1beta1.LLMClientCertificateExpiringMessage,
+				notAfter,
+			))
+		} else {
+			instance.Status.Conditions.MarkTrue(
+				apiv1beta1.LLMClientCertificateReadyCondition,
+				fmt.Sprintf(apiv1beta1.LLMClientCertificateReadyMessage, notAfter),
+			)
+		}
+
+		if checkDelay := GetClientCertificateCheckDelay(certificate, time.Now()); checkDelay > 0 &&
+			(requeueAfter == 0 || checkDelay < requeueAfter) {
+			requeueAfter = checkDelay
+		}
+	} else {
+		instance.Status.LLMClientCertificateNotAfter = nil
+		instance.Status.Conditions.Remove(apiv1beta1.LLMClientCertificateReadyCondition)
+	}
+
+	if instance.Spec.Guardrails != nil {
+		isGuardrailsReady, message, err := EnsureGuardrails(ctx, helper, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.GuardrailsReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.GuardrailsErrorMessage,
+				err.Error(),
+			))
+
+			// The existing GuardrailsOrchestrator may not have been created yet
+			if k8s_errors.IsNotFound(err) {
+				return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+			}
+			return ctrl.Result{}, err
+		} else if !isGuardrailsReady {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.GuardrailsReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				apiv1beta1.GuardrailsWaitingMessage,
+				message,
+			))
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.GuardrailsReadyCondition,
+			apiv1beta1.GuardrailsReadyMessage,
+		)
+	} else {
+		_, err = RemoveGuardrailsOrchestrator(ctx, helper, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		instance.Status.Guardrails = nil
+		instance.Status.Conditions.Remove(apiv1beta1.GuardrailsReadyCondition)
+	}
+
+	toolsUnsupportedReason := ""
+	if instance.Spec.Tools != nil {
+		toolsUnsupportedReason, err = GetToolCallingUnsupportedReason(ctx, helper)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if toolsUnsupportedReason != "" {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.ToolsReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			apiv1beta1.ToolsUnsupportedMessage,
+			toolsUnsupportedReason,
+		))
+
+		// Tool calling is neither deployed nor rendered into the OLSConfig of OLS versions not supporting it
+		instance.Spec.Tools = nil
+		err = RemoveRHOAIMCPServer(ctx, helper, r.APIReader, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if GetRHOAIMCPServer(instance) != nil {
+		isMCPServerReady, message, err := EnsureRHOAIMCPServer(ctx, helper, r.APIReader, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.ToolsReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.ToolsErrorMessage,
+				err.Error(),
+			))
+			return ctrl.Result{}, err
+		} else if !isMCPServerReady {
+			// OLS starts without the MCP server, so the OLSConfig is not held back
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.ToolsReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				apiv1beta1.ToolsWaitingMessage,
+				message,
+			))
+		} else {
+			instance.Status.Conditions.MarkTrue(
+				apiv1beta1.ToolsReadyCondition,
+				apiv1beta1.ToolsReadyMessage,
+			)
+		}
+	} else {
+		err = RemoveRHOAIMCPServer(ctx, helper, r.APIReader, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if instance.Spec.Tools != nil {
+			instance.Status.Conditions.MarkTrue(
+				apiv1beta1.ToolsReadyCondition,
+				apiv1beta1.ToolsReadyMessage,
+			)
+		} else {
+			instance.Status.Conditions.Remove(apiv1beta1.ToolsReadyCondition)
+		}
+	}
+
+	err = EnsureQueryAccess(ctx, helper, r.APIReader, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = EnsureRAGImagePullSecrets(ctx, helper, r.APIReader, instance)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.ImagePullReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			apiv1beta1.ImagePullSecretErrorMessage,
+			err.Error(),
+		))
+
+		// The pull secret may not have been created yet
+		if k8s_errors.IsNotFound(err) {
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if instance.Spec.RAGSource != nil {
+		isRAGSourceStaged, err := EnsureRAGSource(ctx, helper, instance)
+		var jobFailedErr *JobFailedError
+		if errors.As(err, &jobFailedErr) {
+			// The staging is not retried until RAGSource changes
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.RAGSourceReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityError,
+				apiv1beta1.RAGSourceErrorMessage,
+				err.Error(),
+			))
+
+			// OLS keeps serving the content staged from the previous RAG source
+			if instance.Status.RAGSource == nil {
+				return ctrl.Result{}, nil
+			}
+		} else if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.RAGSourceReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.RAGSourceErrorMessage,
+				err.Error(),
+			))
+
+			// OLS keeps serving the content staged from the previous RAG source
+			if instance.Status.RAGSource == nil {
+				return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+			}
+		} else if !isRAGSourceStaged {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.RAGSourceReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				apiv1beta1.RAGSourceWaitingMessage,
+			))
+			if instance.Status.RAGSource == nil {
+				return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+			}
+			requeueAfter = time.Second * 10
+		} else {
+			instance.Status.Conditions.MarkTrue(
+				apiv1beta1.RAGSourceReadyCondition,
+				apiv1beta1.RAGSourceReadyMessage,
+			)
+		}
+
+		instance.Spec.RAGImage = instance.Status.RAGSource.Image
+	} else {
+		err = RemoveRAGSource(ctx, helper, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		instance.Status.RAGSource = nil
+		instance.Status.Conditions.Remove(apiv1beta1.RAGSourceReadyCondition)
+	}
+
+	if instance.Spec.RAGImageUpdates != nil {
+		updateCheckDelay, err := CheckRAGImageUpdates(ctx, helper, r.APIReader, instance, time.Now())
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.RAGImageUpdateAvailableCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.RAGImageUpdateErrorMessage,
+				err.Error(),
+			))
+		} else if latestImage := instance.Status.RAGImageUpdate.LatestImage; latestImage == "" {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.RAGImageUpdateAvailableCondition,
+				condition.ReadyReason,
+				condition.SeverityInfo,
+				apiv1beta1.RAGImageUpToDateMessage,
+				GetUpdatedRAGImage(instance),
+			))
+		} else if instance.Spec.RAGImageUpdates.Policy == apiv1beta1.RAGImageUpdatePolicyAutoApply {
+			instance.Status.Conditions.MarkTrue(
+				apiv1beta1.RAGImageUpdateAvailableCondition,
+				fmt.Sprintf(apiv1beta1.RAGImageUpdatePendingMessage, latestImage),
+			)
+		} else {
+			instance.Status.Conditions.MarkTrue(
+				apiv1beta1.RAGImageUpdateAvailableCondition,
+				fmt.Sprintf(apiv1beta1.RAGImageUpdateAvailableMessage, latestImage),
+			)
+		}
+
+		if requeueAfter == 0 || updateCheckDelay < requeueAfter {
+			requeueAfter = updateCheckDelay
+		}
+
+		// OLS moves to the newer RAG image once it has been applied in the maintenance window
+		instance.Spec.RAGImage = GetUpdatedRAGImage(instance)
+	} else {
+		instance.Status.RAGImageUpdate = nil
+		instance.Status.Conditions.Remove(apiv1beta1.RAGImageUpdateAvailableCondition)
+	}
+
+	// Until the index metadata is discovered OLS is configured with OpenShiftAILightspeedVectorDBPath, and a
+	// failed refresh of a tracked RAG image keeps OLS pinned to the previous digest
+	isRAGContentDiscovered, err := DiscoverRAGContent(ctx, helper, instance)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.RAGContentReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			apiv1beta1.RAGContentErrorMessage,
+			err.Error(),
+		))
+
+		// A failed Job is kept until the RAG image changes, other errors are retried
+		var jobFailedErr *JobFailedError
+		if !errors.As(err, &jobFailedErr) && (requeueAfter == 0 || time.Second*30 < requeueAfter) {
+			requeueAfter = time.Second * 30
+		}
+	} else if !isRAGContentDiscovered {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.RAGContentReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			apiv1beta1.RAGContentWaitingMessage,
+		))
+		if requeueAfter == 0 || time.Second*10 < requeueAfter {
+			requeueAfter = time.Second * 10
+		}
+	} else {
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.RAGContentReadyCondition,
+			apiv1beta1.RAGContentReadyMessage,
+		)
+	}
+
+	if refreshDelay := GetRAGImageRefreshDelay(instance, time.Now()); refreshDelay > 0 &&
+		(requeueAfter == 0 || refreshDelay < requeueAfter) {
+		requeueAfter = refreshDelay
+	}
+
+	additionalRAG, err := GetAdditionalRAG(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The contributions follow the built-in RAG and the LightspeedRAGSources
+	contributedRAG, err := AggregateRAGContributions(ctx, helper, r.APIReader, instance, 1+len(additionalRAG))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	instance.Status.AdditionalRAG = append(additionalRAG, contributedRAG...)
+
+	// The trusted CA bundle of the cluster-wide proxy is merged with the CA bundle for the LLM
+	isCACertBundleInjected, err := EnsureClusterProxy(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if !isCACertBundleInjected {
+		Log.Info("Waiting for the trusted CA bundle of the cluster-wide proxy to be injected ...")
+		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+	}
+
+	if instance.Spec.RestrictEgress {
+		refreshDelay, err := EnsureEgressNetworkPolicy(ctx, helper, r.APIReader, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.EgressNetworkPolicyReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.EgressNetworkPolicyErrorMessage,
+				err.Error(),
+			))
+
+			// The host name of the LLM may not resolve yet or its Service may not have been created yet
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.EgressNetworkPolicyReadyCondition,
+			apiv1beta1.EgressNetworkPolicyReadyMessage,
+		)
+		if refreshDelay > 0 && (requeueAfter == 0 || refreshDelay < requeueAfter) {
+			requeueAfter = refreshDelay
+		}
+	} else {
+		err = RemoveEgressNetworkPolicy(ctx, helper, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		instance.Status.Conditions.Remove(apiv1beta1.EgressNetworkPolicyReadyCondition)
+	}
+
+	// The OLS app server is scaled down to zero replicas in the hibernation windows
+	isHibernating, hibernationEnd, hibernationDelay, err := GetHibernation(instance, time.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if isHibernating {
+		Hibernate(instance)
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.HibernatingCondition,
+			fmt.Sprintf(apiv1beta1.HibernatingMessage, hibernationEnd.UTC().Format(time.RFC3339)),
+		)
+	} else {
+		instance.Status.Conditions.Remove(apiv1beta1.HibernatingCondition)
+	}
+	if hibernationDelay > 0 && (requeueAfter == 0 || hibernationDelay < requeueAfter) {
+		requeueAfter = hibernationDelay
+	}
+
+	// The system prompt stored in a ConfigMap is rendered like an inline one
+	if instance.Spec.SystemPrompt != nil && instance.Spec.SystemPrompt.ConfigMapRef != nil {
+		systemPrompt, err := GetSystemPrompt(ctx, helper, instance)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.OpenShiftAILightspeedReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.OpenShiftAILightspeedSystemPromptErrorMessage,
+				err.Error(),
+			))
+
+			// The ConfigMap is watched, so a missing ConfigMap or an empty prompt is picked up once it is fixed
+			if _, isAPIError := err.(k8s_errors.APIStatus); isAPIError && !k8s_errors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		instance.Spec.SystemPrompt = &apiv1beta1.SystemPromptSpec{Inline: systemPrompt}
+	}
+
+	// The RAG image is final once it has been selected, staged and updated. It is recorded in the status so
+	// that the pods failing to pull it are watched.
+	instance.Status.RAGImages = GetRAGImages(instance)
+	imagePullFailure, err := GetRAGImagePullFailure(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if imagePullFailure != "" {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			apiv1beta1.ImagePullReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			apiv1beta1.ImagePullErrorMessage,
+			imagePullFailure,
+		))
+	} else {
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.ImagePullReadyCondition,
+			apiv1beta1.ImagePullReadyMessage,
+		)
+	}
+
 	// NOTE: We cannot consume the OLSConfig definition directly from the OLS operator's code due to
 	// a conflict in Go versions. When this comment was written, the min. required Go version for
 	// openshift-ai-lightspeed-operator was 1.21 whereas OLS operator required at least Go version 1.23. Once the
@@ -231,6 +917,16 @@ func (r *OpenShiftAILightspeedReconciler) Reconcile(ctx context.Context, req ctr
 		return ctrl.Result{}, err
 	}
 
+	// OLS is not expected to become ready without any app server replica
+	if isHibernating {
+		instance.Status.Conditions.MarkTrue(
+			apiv1beta1.OpenShiftAILightspeedReadyCondition,
+			apiv1beta1.OpenShiftAILightspeedHibernatingMessage,
+		)
+		Log.Info("OpenShiftAILightspeed Reconciled successfully, the OLS app server is hibernating")
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	OLSConfigReady, err := IsOLSConfigReady(ctx, helper)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -248,7 +944,7 @@ func (r *OpenShiftAILightspeedReconciler) Reconcile(ctx context.Context, req ctr
 	}
 
 	Log.Info("OpenShiftAILightspeed Reconciled successfully")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // reconcileDelete reconciles the deletion of OpenShiftAILightspeed instance
@@ -268,6 +964,42 @@ func (r *OpenShiftAILightspeedReconciler) reconcileDelete(
 		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
 	}
 
+	err = RemoveInferenceServiceAccess(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	isGuardrailsRemoved, err := RemoveGuardrailsOrchestrator(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if !isGuardrailsRemoved {
+		Log.Info("GuardrailsOrchestrator removal in progress ...")
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	err = RemoveRHOAIMCPServer(ctx, helper, r.APIReader, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = RemoveQueryAccess(ctx, helper, r.APIReader, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = RemoveRAGSource(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	isManagedModelRemoved, err := RemoveManagedModel(ctx, helper, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if !isManagedModelRemoved {
+		Log.Info("Managed model removal in progress ...")
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
 	isUninstalled, err := UninstallInstanceOwnedOLSOperator(ctx, helper, instance)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -282,18 +1014,211 @@ func (r *OpenShiftAILightspeedReconciler) reconcileDelete(
 	return ctrl.Result{}, nil
 }
 
+// ClusterWideWatchedGVKs contains the kinds of objects referenced by OpenShiftAILightspeed instances
+// that may live outside of WATCH_NAMESPACE. They are cached in all namespaces and watched only when
+// their API is available in the cluster.
+var ClusterWideWatchedGVKs = []schema.GroupVersionKind{
+	InferenceServiceGVK,
+	LlamaStackDistributionGVK,
+}
+
+// ClusterScopedWatchedGVKs contains the kinds of cluster scoped objects read by the controller. Like the ones
+// of ClusterWideWatchedGVKs, they are read from the cache and watched only when their API is available in the
+// cluster.
+var ClusterScopedWatchedGVKs = []schema.GroupVersionKind{
+	DataScienceClusterGVK,
+}
+
+// IsAPIAvailable returns true if the API serving the given GroupVersionKind is available in the cluster.
+func IsAPIAvailable(mapper meta.RESTMapper, gvk schema.GroupVersionKind) bool {
+	_, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	return err == nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *OpenShiftAILightspeedReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&apiv1beta1.OpenShiftAILightspeed{}).
 		Owns(&operatorsv1alpha1.ClusterServiceVersion{}).
 		Owns(&operatorsv1alpha1.Subscription{}).
+		Owns(&corev1.ServiceAccount{}).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
+		Owns(&batchv1.Job{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&networkingv1.NetworkPolicy{}).
 		Watches(
 			&operatorsv1alpha1.InstallPlan{},
 			handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
-		).
-		Complete(r)
+		)
+
+	// Failing pulls of the RAG images are reported from the status of the pods. Only the pods failing to pull
+	// a RAG image, or recovering from it, are watched.
+	controllerBuilder = controllerBuilder.Watches(
+		&corev1.Pod{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+		builder.WithPredicates(predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return r.IsRAGImagePullFailing(e.Object)
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return r.IsRAGImagePullFailing(e.ObjectOld) || r.IsRAGImagePullFailing(e.ObjectNew)
+			},
+			DeleteFunc: func(e event.DeleteEvent) bool {
+				return r.IsRAGImagePullFailing(e.Object)
+			},
+			GenericFunc: func(_ event.GenericEvent) bool {
+				return false
+			},
+		}),
+	)
+
+	// The resources of the OLS deployment are validated against the ResourceQuotas of the namespace
+	controllerBuilder = controllerBuilder.Watches(
+		&corev1.ResourceQuota{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+		builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+	)
+
+	// The RAG images indexed from LightspeedRAGSources are added to the OLSConfig
+	controllerBuilder = controllerBuilder.Watches(
+		&apiv1beta1.LightspeedRAGSource{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+		builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+	)
+
+	// LightspeedRAGContributions from all namespaces are aggregated into the OLSConfig. Only spec changes are
+	// watched as the status of the contributions is written by this controller.
+	controllerBuilder = controllerBuilder.Watches(
+		&apiv1beta1.LightspeedRAGContribution{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyRAGContributionAggregators),
+		builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+	)
+
+	// Namespaces may start or stop matching the RAGContributions namespace selector
+	controllerBuilder = controllerBuilder.Watches(
+		&corev1.Namespace{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyRAGContributionAggregators),
+		builder.WithPredicates(predicate.LabelChangedPredicate{}),
+	)
+
+	// The RAG image is selected with the OpenShiftAILightspeedRAGImagesConfigMapName ConfigMap which is
+	// not owned by any instance
+	controllerBuilder = controllerBuilder.Watches(
+		&corev1.ConfigMap{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+		builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetName() == OpenShiftAILightspeedRAGImagesConfigMapName
+		})),
+	)
+
+	// The additional system prompt and the CA bundle for the LLM merged with the trusted CA bundle of the
+	// cluster-wide proxy may be stored in ConfigMaps which are not owned by any instance
+	controllerBuilder = controllerBuilder.Watches(
+		&corev1.ConfigMap{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyConfigMapReferrers),
+		builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+	)
+
+	// The client certificate presented to the LLM provider and the pull secrets of the RAG images are stored in
+	// secrets which are not owned by any instance
+	controllerBuilder = controllerBuilder.Watches(
+		&corev1.Secret{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifySecretReferrers),
+		builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+	)
+
+	// The subjects bound to the OLS query ClusterRole are listed in the status, including the ones bound by
+	// ClusterRoleBindings which are not owned by any instance
+	controllerBuilder = controllerBuilder.Watches(
+		&rbacv1.ClusterRoleBinding{},
+		handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+		builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			clusterRoleBinding, ok := obj.(*rbacv1.ClusterRoleBinding)
+			return ok && clusterRoleBinding.RoleRef.Kind == "ClusterRole" &&
+				clusterRoleBinding.RoleRef.Name == OLSQueryClusterRole
+		})),
+	)
+
+	// The cluster-wide proxy settings are passed to the OLS operator and OLS
+	if IsAPIAvailable(mgr.GetRESTMapper(), ProxyGVK) {
+		proxy := &uns.Unstructured{}
+		proxy.SetGroupVersionKind(ProxyGVK)
+		controllerBuilder = controllerBuilder.Watches(
+			proxy,
+			handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetName() == ClusterProxyName
+			})),
+		)
+	}
+
+	if IsAPIAvailable(mgr.GetRESTMapper(), InferenceServiceGVK) {
+		inferenceService := &uns.Unstructured{}
+		inferenceService.SetGroupVersionKind(InferenceServiceGVK)
+		controllerBuilder = controllerBuilder.Watches(
+			inferenceService,
+			handler.EnqueueRequestsFromMapFunc(r.NotifyInferenceServiceReferrers),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		)
+	}
+
+	if IsAPIAvailable(mgr.GetRESTMapper(), LlamaStackDistributionGVK) {
+		distribution := &uns.Unstructured{}
+		distribution.SetGroupVersionKind(LlamaStackDistributionGVK)
+		controllerBuilder = controllerBuilder.Watches(
+			distribution,
+			handler.EnqueueRequestsFromMapFunc(r.NotifyLlamaStackDistributionReferrers),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		)
+	}
+
+	// The RAG image is selected with the OpenShift AI version reported by the DataScienceCluster
+	if IsAPIAvailable(mgr.GetRESTMapper(), DataScienceClusterGVK) {
+		dataScienceCluster := &uns.Unstructured{}
+		dataScienceCluster.SetGroupVersionKind(DataScienceClusterGVK)
+		controllerBuilder = controllerBuilder.Watches(
+			dataScienceCluster,
+			handler.EnqueueRequestsFromMapFunc(r.NotifyAllOpenShiftAILightspeeds),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		)
+	}
+
+	if IsAPIAvailable(mgr.GetRESTMapper(), GuardrailsOrchestratorGVK) {
+		orchestrator := &uns.Unstructured{}
+		orchestrator.SetGroupVersionKind(GuardrailsOrchestratorGVK)
+		controllerBuilder = controllerBuilder.Watches(
+			orchestrator,
+			handler.EnqueueRequestsFromMapFunc(r.NotifyGuardrailsOrchestratorReferrers),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		)
+	}
+
+	return controllerBuilder.Complete(r)
+}
+
+// IsRAGImagePullFailing returns true if the given object is a pod that cannot pull the RAG image of one of the
+// OpenShiftAILightspeed objects in its namespace.
+func (r *OpenShiftAILightspeedReconciler) IsRAGImagePullFailing(obj client.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false
+	}
+
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(context.Background(), &lightspeedList, client.InNamespace(pod.Namespace)); err != nil {
+		return false
+	}
+
+	// The effective RAG images are only known from the status, the spec holds the configured ones
+	for _, item := range lightspeedList.Items {
+		if GetImagePullFailure([]corev1.Pod{*pod}, item.Status.RAGImages) != "" {
+			return true
+		}
+	}
+
+	return false
 }
 
 // NotifyAllOpenShiftAILightspeeds returns a list of reconcile requests for all OpenShiftAILightspeed objects
@@ -318,3 +1243,179 @@ func (r *OpenShiftAILightspeedReconciler) NotifyAllOpenShiftAILightspeeds(ctx co
 
 	return requests
 }
+
+// NotifyRAGContributionAggregators returns a list of reconcile requests for all OpenShiftAILightspeed objects
+// that accept LightspeedRAGContributions. This is used to pick up contributions and namespaces in any
+// namespace.
+func (r *OpenShiftAILightspeedReconciler) NotifyRAGContributionAggregators(
+	ctx context.Context,
+	obj client.Object,
+) []ctrl.Request {
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(ctx, &lightspeedList); err != nil {
+		return nil
+	}
+
+	_, isNamespace := obj.(*corev1.Namespace)
+
+	var requests []ctrl.Request
+	for _, item := range lightspeedList.Items {
+		// Namespace labels only matter to the instances selecting the contributing namespaces
+		if isNamespace && (item.Spec.RAGContributions == nil || item.Spec.RAGContributions.NamespaceSelector == nil) {
+			continue
+		}
+
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+	}
+
+	return requests
+}
+
+// NotifyInferenceServiceReferrers returns a list of reconcile requests for all OpenShiftAILightspeed objects
+// that reference the given InferenceService. This is used to pick up changes of the InferenceService URL
+// and readiness.
+func (r *OpenShiftAILightspeedReconciler) NotifyInferenceServiceReferrers(ctx context.Context, obj client.Object) []ctrl.Request {
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(ctx, &lightspeedList); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, item := range lightspeedList.Items {
+		if !IsReferencedInferenceService(&item, obj.GetName(), obj.GetNamespace()) {
+			continue
+		}
+
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+	}
+
+	return requests
+}
+
+// NotifyConfigMapReferrers returns a list of reconcile requests for all OpenShiftAILightspeed objects that
+// read their additional system prompt from the given ConfigMap, or merge it into the CA bundle OLS is
+// configured with. This is used to pick up changes of the prompt and of the CA bundle for the LLM.
+func (r *OpenShiftAILightspeedReconciler) NotifyConfigMapReferrers(
+	ctx context.Context,
+	obj client.Object,
+) []ctrl.Request {
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(ctx, &lightspeedList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, item := range lightspeedList.Items {
+		if !IsReferencedSystemPromptConfigMap(&item, obj.GetName(), obj.GetNamespace()) &&
+			!IsReferencedCACertBundle(&item, obj.GetName(), obj.GetNamespace()) {
+			continue
+		}
+
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+	}
+
+	return requests
+}
+
+// NotifySecretReferrers returns a list of reconcile requests for all OpenShiftAILightspeed objects that
+// present the client certificate stored in the given secret to the LLM provider or pull the RAG images with
+// it. This is used to pick up renewals of the certificate and updated registry credentials.
+func (r *OpenShiftAILightspeedReconciler) NotifySecretReferrers(
+	ctx context.Context,
+	obj client.Object,
+) []ctrl.Request {
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(ctx, &lightspeedList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, item := range lightspeedList.Items {
+		if !IsReferencedLLMClientCertificate(&item, obj.GetName(), obj.GetNamespace()) &&
+			!IsReferencedRAGImagePullSecret(&item, obj.GetName(), obj.GetNamespace()) {
+			continue
+		}
+
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+	}
+
+	return requests
+}
+
+// NotifyLlamaStackDistributionReferrers returns a list of reconcile requests for all OpenShiftAILightspeed
+// objects that reference the given LlamaStackDistribution. This is used to pick up changes of the
+// distribution URL and readiness.
+func (r *OpenShiftAILightspeedReconciler) NotifyLlamaStackDistributionReferrers(
+	ctx context.Context,
+	obj client.Object,
+) []ctrl.Request {
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(ctx, &lightspeedList); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, item := range lightspeedList.Items {
+		if !IsReferencedLlamaStackDistribution(&item, obj.GetName(), obj.GetNamespace()) {
+			continue
+		}
+
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+	}
+
+	return requests
+}
+
+// NotifyGuardrailsOrchestratorReferrers returns a list of reconcile requests for all OpenShiftAILightspeed
+// objects in the same namespace that send the LLM requests through the given GuardrailsOrchestrator. This
+// is used to pick up changes of the orchestrator readiness.
+func (r *OpenShiftAILightspeedReconciler) NotifyGuardrailsOrchestratorReferrers(
+	ctx context.Context,
+	obj client.Object,
+) []ctrl.Request {
+	var lightspeedList apiv1beta1.OpenShiftAILightspeedList
+	if err := r.List(ctx, &lightspeedList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []ctrl.Request
+	for _, item := range lightspeedList.Items {
+		if GetGuardrailsOrchestratorName(&item) != obj.GetName() {
+			continue
+		}
+
+		requests = append(requests, ctrl.Request{
+			NamespacedName: client.ObjectKey{
+				Namespace: item.GetNamespace(),
+				Name:      item.GetName(),
+			},
+		})
+	}
+
+	return requests
+}
@@ -76,8 +76,9 @@ var _ = Describe("OpenShiftAILightspeed Controller", func() {
 		It("should successfully reconcile the resource", func() {
 			By("Reconciling the created resource")
 			controllerReconciler := &OpenShiftAILightspeedReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+				Client:    k8sClient,
+				Scheme:    k8sClient.Scheme(),
+				APIReader: k8sClient,
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
@@ -88,4 +89,61 @@ var _ = Describe("OpenShiftAILightspeed Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("When the reconciliation is paused", func() {
+		const resourceName = "test-paused-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &apiv1beta1.OpenShiftAILightspeed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+					Annotations: map[string]string{
+						OpenShiftAILightspeedPausedAnnotation: "true",
+					},
+				},
+				Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+					OpenShiftAILightspeedCore: apiv1beta1.OpenShiftAILightspeedCore{
+						LLMEndpoint:     "http://localhost:11434/v1",
+						LLMEndpointType: "openai",
+						LLMCredentials:  "test-secret",
+						ModelName:       "llama3.1:8b",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &apiv1beta1.OpenShiftAILightspeed{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should only report the Paused condition", func() {
+			controllerReconciler := &OpenShiftAILightspeedReconciler{
+				Client:    k8sClient,
+				Scheme:    k8sClient.Scheme(),
+				APIReader: k8sClient,
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			resource := &apiv1beta1.OpenShiftAILightspeed{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.GetFinalizers()).To(BeEmpty())
+			Expect(resource.Status.Conditions.IsTrue(apiv1beta1.PausedCondition)).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,267 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for adopting the cluster-wide proxy and its trusted CA bundle.
+package controller
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// ClusterProxyName - name of the cluster-wide Proxy
+	ClusterProxyName = "cluster"
+
+	// TrustedCABundleName - name of the ConfigMap the Cluster Network Operator injects the trusted CA bundle of
+	// the cluster into
+	TrustedCABundleName = "openshift-ai-lightspeed-trusted-ca"
+
+	// MergedCACertBundleName - name of the ConfigMap merging the trusted CA bundle of the cluster with the CA
+	// bundle of the LLM, which OLS is configured with
+	MergedCACertBundleName = "openshift-ai-lightspeed-ca-bundle"
+
+	// TrustedCABundleKey - key of the injected trusted CA bundle, also used for the merged CA bundle
+	TrustedCABundleKey = "ca-bundle.crt"
+
+	// trustedCABundleInjectLabel - label requesting the injection of the trusted CA bundle into a ConfigMap
+	trustedCABundleInjectLabel = "config.openshift.io/inject-trusted-cabundle"
+)
+
+// ProxyGVK - GroupVersionKind of the OpenShift cluster-wide Proxy
+var ProxyGVK = schema.GroupVersionKind{
+	Group:   "config.openshift.io",
+	Version: "v1",
+	Kind:    "Proxy",
+}
+
+// ClusterProxy contains the effective settings of the cluster-wide proxy
+type ClusterProxy struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+}
+
+// GetClusterProxy returns the effective settings of the cluster-wide Proxy, or nil when no proxy is configured
+// or the Proxy API is not available.
+func GetClusterProxy(ctx context.Context, helper *common_helper.Helper) (*ClusterProxy, error) {
+	proxy := &uns.Unstructured{}
+	proxy.SetGroupVersionKind(ProxyGVK)
+	err := helper.GetClient().Get(ctx, client.ObjectKey{Name: ClusterProxyName}, proxy)
+	if err != nil && (k8s_errors.IsNotFound(err) || meta.IsNoMatchError(err)) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The status holds the settings in use, including the noProxy entries added by the cluster
+	httpProxy, _, _ := uns.NestedString(proxy.Object, "status", "httpProxy")
+	httpsProxy, _, _ := uns.NestedString(proxy.Object, "status", "httpsProxy")
+	noProxy, _, _ := uns.NestedString(proxy.Object, "status", "noProxy")
+	if httpProxy == "" && httpsProxy == "" {
+		return nil, nil
+	}
+
+	return &ClusterProxy{
+		HTTPProxy:  httpProxy,
+		HTTPSProxy: httpsProxy,
+		NoProxy:    noProxy,
+	}, nil
+}
+
+// GetProxyEnv returns the environment variables configuring the proxy, or nil when proxy is nil.
+func GetProxyEnv(proxy *ClusterProxy) []corev1.EnvVar {
+	if proxy == nil {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+	for _, proxyEnv := range []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: proxy.HTTPProxy},
+		{Name: "HTTPS_PROXY", Value: proxy.HTTPSProxy},
+		{Name: "NO_PROXY", Value: proxy.NoProxy},
+	} {
+		if proxyEnv.Value != "" {
+			env = append(env, proxyEnv)
+		}
+	}
+
+	return env
+}
+
+// RedactProxyURL returns the proxy URL without the credentials it may contain.
+func RedactProxyURL(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || parsedURL.User == nil {
+		return rawURL
+	}
+
+	parsedURL.User = nil
+	return parsedURL.String()
+}
+
+// EnsureClusterProxy adopts the cluster-wide proxy: it creates the ConfigMap the trusted CA bundle of the
+// cluster is injected into, and merges it with the CA bundle of the LLM into the ConfigMap OLS is configured
+// with. The ConfigMaps are removed when no proxy is configured. Returns false while the trusted CA bundle has
+// not been injected yet.
+func EnsureClusterProxy(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, error) {
+	proxy, err := GetClusterProxy(ctx, helper)
+	if err != nil {
+		return false, err
+	}
+
+	if proxy == nil {
+		instance.Status.Proxy = nil
+		return true, RemoveClusterProxyCACertBundles(ctx, helper, instance)
+	}
+
+	instance.Status.Proxy = &apiv1beta1.ProxyStatus{
+		HTTPProxy:  RedactProxyURL(proxy.HTTPProxy),
+		HTTPSProxy: RedactProxyURL(proxy.HTTPSProxy),
+		NoProxy:    proxy.NoProxy,
+	}
+
+	trustedCABundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TrustedCABundleName,
+			Namespace: instance.Namespace,
+		},
+	}
+	// The data is owned by the Cluster Network Operator, only the label is set
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), trustedCABundle, func() error {
+		labels := trustedCABundle.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[trustedCABundleInjectLabel] = "true"
+		trustedCABundle.SetLabels(labels)
+
+		return controllerutil.SetControllerReference(instance, trustedCABundle, helper.GetScheme())
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if trustedCABundle.Data[TrustedCABundleKey] == "" {
+		return false, nil
+	}
+
+	var llmCACertBundle map[string]string
+	if llmCACertBundleName := GetLLMTLSCACertBundle(instance); llmCACertBundleName != "" {
+		configMap := &corev1.ConfigMap{}
+		err = helper.GetClient().Get(ctx, client.ObjectKey{
+			Name:      llmCACertBundleName,
+			Namespace: instance.Namespace,
+		}, configMap)
+		if err != nil {
+			return false, err
+		}
+		llmCACertBundle = configMap.Data
+	}
+
+	mergedCACertBundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      MergedCACertBundleName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), mergedCACertBundle, func() error {
+		mergedCACertBundle.Data = map[string]string{
+			TrustedCABundleKey: MergeCACertBundles(trustedCABundle.Data[TrustedCABundleKey], llmCACertBundle),
+		}
+
+		return controllerutil.SetControllerReference(instance, mergedCACertBundle, helper.GetScheme())
+	})
+	if err != nil {
+		return false, err
+	}
+
+	instance.Status.Proxy.CACertBundle = MergedCACertBundleName
+	return true, nil
+}
+
+// MergeCACertBundles returns the trusted CA bundle followed by the certificates of all the keys of the CA
+// bundle of the LLM, in the order of the keys. The trusted CA bundle may be empty.
+func MergeCACertBundles(trustedCABundle string, llmCACertBundle map[string]string) string {
+	var bundles []string
+	if bundle := strings.TrimSpace(trustedCABundle); bundle != "" {
+		bundles = append(bundles, bundle)
+	}
+
+	keys := make([]string, 0, len(llmCACertBundle))
+	for key := range llmCACertBundle {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if bundle := strings.TrimSpace(llmCACertBundle[key]); bundle != "" {
+			bundles = append(bundles, bundle)
+		}
+	}
+
+	return strings.Join(bundles, "\n") + "\n"
+}
+
+// RemoveClusterProxyCACertBundles deletes the ConfigMaps created by EnsureClusterProxy if they are owned by the
+// instance.
+func RemoveClusterProxyCACertBundles(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	configMapGVK := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+	for _, name := range []string{MergedCACertBundleName, TrustedCABundleName} {
+		_, err := RemoveInstanceOwnedObject(ctx, helper, instance, configMapGVK, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsReferencedCACertBundle returns true if the instance merges the ConfigMap with the given name and namespace
+// into the CA bundle OLS is configured with, or copies it into the operator managed GuardrailsOrchestrator.
+func IsReferencedCACertBundle(instance *apiv1beta1.OpenShiftAILightspeed, name string, namespace string) bool {
+	if instance.Namespace != namespace {
+		return false
+	}
+
+	if instance.Status.Proxy != nil && GetLLMTLSCACertBundle(instance) == name {
+		return true
+	}
+
+	return GetGuardrailsOrchestratorName(instance) == OpenShiftAILightspeedGuardrailsName &&
+		GetUpstreamLLMTLSCACertBundle(instance) == name
+}
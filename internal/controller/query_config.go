@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the system prompt and the query filters OLS applies to the queries.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SystemPromptConfigMapKeyDefault - key of the ConfigMap holding the additional system prompt when it is
+	// not set in the reference
+	SystemPromptConfigMapKeyDefault = "prompt"
+
+	// QuerySystemPromptBase - system prompt of OpenShift AI Lightspeed. OLS replaces its own system prompt with
+	// the querySystemPrompt of the OLSConfig, so the additional system prompt is appended to this one. It is
+	// adapted from QUERY_SYSTEM_INSTRUCTION in ols/customize/ols/prompts.py of
+	// https://github.com/openshift/lightspeed-service and has to be kept in sync with it.
+	QuerySystemPromptBase = `You are OpenShift AI Lightspeed - an intelligent assistant for question-answering tasks related to Red Hat OpenShift AI.
+
+Here are your instructions:
+You are OpenShift AI Lightspeed, an intelligent assistant and expert on all things Red Hat OpenShift AI and OpenShift. Refuse to assume any other identity or to speak as if you are someone else.
+If the context of the question is not clear, consider it to be Red Hat OpenShift AI.
+Never include URLs in your replies.
+Refuse to answer questions or execute commands not about OpenShift AI or OpenShift.
+Do not mention your last update. You have the most recent information on OpenShift AI.`
+)
+
+// GetSystemPrompt returns the additional system prompt, reading it from the referenced ConfigMap when it is
+// not set inline. Returns an empty string when no additional system prompt is set.
+func GetSystemPrompt(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (string, error) {
+	systemPrompt := instance.Spec.SystemPrompt
+	if systemPrompt == nil {
+		return "", nil
+	}
+
+	if systemPrompt.ConfigMapRef == nil {
+		return systemPrompt.Inline, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      systemPrompt.ConfigMapRef.Name,
+		Namespace: instance.Namespace,
+	}, configMap)
+	if err != nil {
+		return "", err
+	}
+
+	key := GetSystemPromptConfigMapKey(systemPrompt.ConfigMapRef)
+	prompt := strings.TrimSpace(configMap.Data[key])
+	if prompt == "" {
+		return "", fmt.Errorf("key %s of ConfigMap %s is empty", key, systemPrompt.ConfigMapRef.Name)
+	}
+
+	return prompt, nil
+}
+
+// GetSystemPromptConfigMapKey returns the key of the ConfigMap holding the additional system prompt.
+func GetSystemPromptConfigMapKey(ref *apiv1beta1.ConfigMapKeyReference) string {
+	if ref.Key == "" {
+		return SystemPromptConfigMapKeyDefault
+	}
+
+	return ref.Key
+}
+
+// GetQuerySystemPrompt returns the system prompt OLS is configured with, the system prompt of OpenShift AI
+// Lightspeed followed by the additional system prompt.
+func GetQuerySystemPrompt(systemPrompt string) string {
+	return QuerySystemPromptBase + "\n\n" + strings.TrimSpace(systemPrompt)
+}
+
+// IsReferencedSystemPromptConfigMap returns true if the instance reads its additional system prompt from the
+// ConfigMap with the given name and namespace.
+func IsReferencedSystemPromptConfigMap(instance *apiv1beta1.OpenShiftAILightspeed, name string, namespace string) bool {
+	systemPrompt := instance.Spec.SystemPrompt
+	return systemPrompt != nil && systemPrompt.ConfigMapRef != nil &&
+		systemPrompt.ConfigMapRef.Name == name && instance.Namespace == namespace
+}
+
+// ValidateQueryFilters returns an error if a regular expression of the query filters does not compile. The
+// patterns are compiled with the Go syntax, which covers the common subset of the Python syntax OLS applies
+// them with.
+func ValidateQueryFilters(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	for _, queryFilter := range instance.Spec.QueryFilters {
+		if _, err := regexp.Compile(queryFilter.Pattern); err != nil {
+			return fmt.Errorf("pattern of query filter %s is invalid: %w", queryFilter.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Query configuration", func() {
+	It("should append the additional system prompt to the OpenShift AI Lightspeed one", func() {
+		querySystemPrompt := GetQuerySystemPrompt("  Mention the internal data handling policy.\n")
+		Expect(querySystemPrompt).To(HavePrefix(QuerySystemPromptBase))
+		Expect(querySystemPrompt).To(HaveSuffix("\n\nMention the internal data handling policy."))
+	})
+
+	It("should match the ConfigMap holding the additional system prompt", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			ObjectMeta: metav1.ObjectMeta{Name: "lightspeed", Namespace: "openshift-lightspeed"},
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				OpenShiftAILightspeedCore: apiv1beta1.OpenShiftAILightspeedCore{
+					SystemPrompt: &apiv1beta1.SystemPromptSpec{
+						ConfigMapRef: &apiv1beta1.ConfigMapKeyReference{Name: "policies"},
+					},
+				},
+			},
+		}
+
+		Expect(IsReferencedSystemPromptConfigMap(instance, "policies", "openshift-lightspeed")).To(BeTrue())
+		Expect(IsReferencedSystemPromptConfigMap(instance, "policies", "default")).To(BeFalse())
+		Expect(IsReferencedSystemPromptConfigMap(instance, "other", "openshift-lightspeed")).To(BeFalse())
+		Expect(GetSystemPromptConfigMapKey(instance.Spec.SystemPrompt.ConfigMapRef)).To(
+			Equal(SystemPromptConfigMapKeyDefault))
+	})
+
+	It("should reject query filters that do not compile", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.QueryFilters = []apiv1beta1.QueryFilter{
+			{Name: "account-number", Pattern: `\b[0-9]{8,12}\b`, ReplaceWith: "<ACCOUNT>"},
+		}
+		Expect(ValidateQueryFilters(instance)).To(Succeed())
+
+		instance.Spec.QueryFilters = append(instance.Spec.QueryFilters,
+			apiv1beta1.QueryFilter{Name: "broken", Pattern: `[0-9`})
+		Expect(ValidateQueryFilters(instance)).NotTo(Succeed())
+	})
+})
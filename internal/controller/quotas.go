@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the token quotas OLS enforces on the LLM usage.
+package controller
+
+import (
+	"fmt"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// TokenQuotaPeriodDefault - period after which a token budget is reset when it is not set
+	TokenQuotaPeriodDefault = "1 day"
+
+	// UserQuotaLimiterName - name of the OLS quota limiter of the tokens consumed by each user
+	UserQuotaLimiterName = "openshift-ai-lightspeed-user-tokens"
+
+	// ClusterQuotaLimiterName - name of the OLS quota limiter of the tokens consumed by all the users
+	ClusterQuotaLimiterName = "openshift-ai-lightspeed-cluster-tokens"
+
+	// userQuotaLimiterType - OLS quota limiter type limiting the tokens per user
+	userQuotaLimiterType = "user_limiter"
+
+	// clusterQuotaLimiterType - OLS quota limiter type limiting the tokens of the whole cluster
+	clusterQuotaLimiterType = "cluster_limiter"
+)
+
+// ValidateQuotas returns an error if quotas are set without a persistent conversation cache. OLS stores the
+// token usage in the Postgres conversation cache, which only survives restarts with a PersistentVolumeClaim.
+func ValidateQuotas(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	if instance.Spec.Quotas == nil {
+		return nil
+	}
+
+	if instance.Spec.ConversationCache == nil || instance.Spec.ConversationCache.StorageSize == nil {
+		return fmt.Errorf("quotas require conversationCache.storageSize to persist the token usage")
+	}
+
+	return nil
+}
+
+// PatchOLSQuotas sets the quota handlers of the OLSConfig from Quotas, or removes them when no quota is set.
+// The conversation cache storing the token usage is set to Postgres explicitly, so it does not depend on the
+// defaults of the OLS operator.
+func PatchOLSQuotas(instance *apiv1beta1.OpenShiftAILightspeed, olsConfig *uns.Unstructured) error {
+	quotas := instance.Spec.Quotas
+	if quotas == nil {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "quotaHandlersConfig")
+		return nil
+	}
+
+	limiters := []interface{}{}
+	if quotas.User != nil {
+		limiters = append(limiters, getQuotaLimiter(UserQuotaLimiterName, userQuotaLimiterType, quotas.User))
+	}
+	if quotas.Cluster != nil {
+		limiters = append(limiters, getQuotaLimiter(ClusterQuotaLimiterName, clusterQuotaLimiterType, quotas.Cluster))
+	}
+
+	quotaHandlersConfig := map[string]interface{}{
+		"limitersConfig": limiters,
+	}
+	if quotas.TokenHistory {
+		quotaHandlersConfig["enableTokenHistory"] = true
+	}
+
+	err := uns.SetNestedMap(olsConfig.Object, quotaHandlersConfig, "spec", "ols", "quotaHandlersConfig")
+	if err != nil {
+		return err
+	}
+
+	return uns.SetNestedField(olsConfig.Object, ConversationCacheTypePostgres, "spec", "ols", "conversationCache", "type")
+}
+
+// getQuotaLimiter returns an OLS quota limiter resetting the budget of quota to its limit every period.
+func getQuotaLimiter(name string, limiterType string, quota *apiv1beta1.TokenQuota) map[string]interface{} {
+	period := quota.Period
+	if period == "" {
+		period = TokenQuotaPeriodDefault
+	}
+
+	return map[string]interface{}{
+		"name":          name,
+		"type":          limiterType,
+		"initialQuota":  quota.Limit,
+		"quotaIncrease": int64(0),
+		"period":        period,
+	}
+}
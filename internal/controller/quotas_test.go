@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Token quotas", func() {
+	It("should require a persistent conversation cache", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		Expect(ValidateQuotas(instance)).To(Succeed())
+
+		instance.Spec.Quotas = &apiv1beta1.QuotasSpec{User: &apiv1beta1.TokenQuota{Limit: 100000}}
+		Expect(ValidateQuotas(instance)).NotTo(Succeed())
+
+		instance.Spec.ConversationCache = &apiv1beta1.ConversationCacheSpec{StorageSize: ptr.To(resource.MustParse("5Gi"))}
+		Expect(ValidateQuotas(instance)).To(Succeed())
+	})
+
+	It("should set and remove the quota handlers of the OLSConfig", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.Quotas = &apiv1beta1.QuotasSpec{
+			User:    &apiv1beta1.TokenQuota{Limit: 100000},
+			Cluster: &apiv1beta1.TokenQuota{Limit: 5000000, Period: "4 weeks"},
+		}
+		olsConfig := &uns.Unstructured{Object: map[string]interface{}{}}
+		Expect(PatchOLSQuotas(instance, olsConfig)).To(Succeed())
+
+		limiters, _, _ := uns.NestedSlice(olsConfig.Object, "spec", "ols", "quotaHandlersConfig", "limitersConfig")
+		Expect(limiters).To(HaveLen(2))
+		Expect(limiters[0]).To(HaveKeyWithValue("type", userQuotaLimiterType))
+		Expect(limiters[0]).To(HaveKeyWithValue("initialQuota", int64(100000)))
+		Expect(limiters[0]).To(HaveKeyWithValue("period", TokenQuotaPeriodDefault))
+		Expect(limiters[1]).To(HaveKeyWithValue("type", clusterQuotaLimiterType))
+		Expect(limiters[1]).To(HaveKeyWithValue("period", "4 weeks"))
+		cacheType, _, _ := uns.NestedString(olsConfig.Object, "spec", "ols", "conversationCache", "type")
+		Expect(cacheType).To(Equal(ConversationCacheTypePostgres))
+
+		instance.Spec.Quotas = nil
+		Expect(PatchOLSQuotas(instance, olsConfig)).To(Succeed())
+		_, found, _ := uns.NestedMap(olsConfig.Object, "spec", "ols", "quotaHandlersConfig")
+		Expect(found).To(BeFalse())
+	})
+})
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for aggregating the LightspeedRAGContributions of application namespaces into
+// the OLSConfig RAG.
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	"github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/condition"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AggregateRAGContributions returns the RAG entries of the LightspeedRAGContributions accepted from the
+// namespaces allowed in RAGContributions, in the order they are added to the OLSConfig. offset is the
+// number of RAG entries preceding the contributions. The acceptance and the position of every contribution
+// is reported in its status.
+func AggregateRAGContributions(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	offset int,
+) ([]apiv1beta1.AdditionalRAGStatus, error) {
+	var contributions apiv1beta1.LightspeedRAGContributionList
+	err := helper.GetClient().List(ctx, &contributions)
+	if err != nil {
+		return nil, err
+	}
+
+	var accepted []apiv1beta1.LightspeedRAGContribution
+	ranks := map[string]int{}
+	rejections := map[string]string{}
+	for _, contribution := range contributions.Items {
+		if !contribution.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		key := client.ObjectKeyFromObject(&contribution).String()
+
+		rank, found := ranks[contribution.Namespace]
+		if !found {
+			rank, err = GetRAGContributionRank(ctx, reader, instance, contribution.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			ranks[contribution.Namespace] = rank
+		}
+		if rank < 0 {
+			rejections[key] = fmt.Sprintf("namespace %s is not allowed to contribute", contribution.Namespace)
+			continue
+		}
+
+		if err := ValidateRAGContribution(&contribution); err != nil {
+			rejections[key] = err.Error()
+			continue
+		}
+
+		accepted = append(accepted, contribution)
+	}
+
+	slices.SortFunc(accepted, func(a, b apiv1beta1.LightspeedRAGContribution) int {
+		return cmp.Or(
+			cmp.Compare(ranks[a.Namespace], ranks[b.Namespace]),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	var additionalRAG []apiv1beta1.AdditionalRAGStatus
+	positions := map[string]int32{}
+	for _, contribution := range accepted {
+		key := client.ObjectKeyFromObject(&contribution).String()
+		positions[key] = int32(offset + len(additionalRAG))
+
+		for _, entry := range contribution.Spec.RAG {
+			additionalRAG = append(additionalRAG, apiv1beta1.AdditionalRAGStatus{
+				Source:    key,
+				Image:     entry.Image,
+				IndexPath: entry.IndexPath,
+				IndexID:   entry.IndexID,
+			})
+		}
+	}
+
+	for i := range contributions.Items {
+		contribution := &contributions.Items[i]
+		if !contribution.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		key := client.ObjectKeyFromObject(contribution).String()
+		status := contribution.Status.DeepCopy()
+		status.ObservedGeneration = contribution.Generation
+		if position, found := positions[key]; found {
+			status.Position = ptr.To(position)
+			status.Conditions.MarkTrue(
+				apiv1beta1.RAGContributionAcceptedCondition,
+				fmt.Sprintf(apiv1beta1.RAGContributionAcceptedMessage, client.ObjectKeyFromObject(instance)),
+			)
+		} else {
+			status.Position = nil
+			status.Conditions.Set(condition.FalseCondition(
+				apiv1beta1.RAGContributionAcceptedCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				apiv1beta1.RAGContributionRejectedMessage,
+				rejections[key],
+			))
+		}
+		condition.RestoreLastTransitionTimes(&status.Conditions, &contribution.Status.Conditions)
+
+		if equality.Semantic.DeepEqual(*status, contribution.Status) {
+			continue
+		}
+
+		patch := client.MergeFrom(contribution.DeepCopy())
+		contribution.Status = *status
+		err = helper.GetClient().Status().Patch(ctx, contribution, patch)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return additionalRAG, nil
+}
+
+// GetRAGContributionRank returns the position of the namespace among the namespaces allowed to contribute to
+// the RAG of the instance, or -1 if it is not allowed. Namespaces listed in AllowedNamespaces come first, in
+// their order, followed by the namespaces matching the NamespaceSelector.
+func GetRAGContributionRank(
+	ctx context.Context,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	namespace string,
+) (int, error) {
+	ragContributions := instance.Spec.RAGContributions
+	if ragContributions == nil {
+		return -1, nil
+	}
+
+	if rank := slices.Index(ragContributions.AllowedNamespaces, namespace); rank >= 0 {
+		return rank, nil
+	}
+
+	if ragContributions.NamespaceSelector == nil {
+		return -1, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ragContributions.NamespaceSelector)
+	if err != nil {
+		return -1, err
+	}
+
+	// Namespaces are cluster scoped
+	ns := &corev1.Namespace{}
+	err = reader.Get(ctx, client.ObjectKey{Name: namespace}, ns)
+	if k8s_errors.IsNotFound(err) {
+		return -1, nil
+	} else if err != nil {
+		return -1, err
+	}
+
+	if !selector.Matches(labels.Set(ns.GetLabels())) {
+		return -1, nil
+	}
+
+	return len(ragContributions.AllowedNamespaces), nil
+}
+
+// ValidateRAGContribution returns an error if the entries of the LightspeedRAGContribution cannot be added to
+// the OLSConfig.
+func ValidateRAGContribution(contribution *apiv1beta1.LightspeedRAGContribution) error {
+	for _, entry := range contribution.Spec.RAG {
+		if !path.IsAbs(entry.IndexPath) {
+			return fmt.Errorf("indexPath %q of image %s must be absolute", entry.IndexPath, entry.Image)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRAGContributions returns an error if the namespace selector in RAGContributions is invalid.
+func ValidateRAGContributions(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	if instance.Spec.RAGContributions == nil || instance.Spec.RAGContributions.NamespaceSelector == nil {
+		return nil
+	}
+
+	_, err := metav1.LabelSelectorAsSelector(instance.Spec.RAGContributions.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("ragContributions.namespaceSelector is invalid: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RAG contributions", func() {
+	It("should rank the allowed namespaces in order", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				RAGContributions: &apiv1beta1.RAGContributionsSpec{
+					AllowedNamespaces: []string{"team-b", "team-a"},
+				},
+			},
+		}
+
+		rank, err := GetRAGContributionRank(context.Background(), nil, instance, "team-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rank).To(Equal(1))
+
+		rank, err = GetRAGContributionRank(context.Background(), nil, instance, "team-b")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rank).To(Equal(0))
+
+		rank, err = GetRAGContributionRank(context.Background(), nil, instance, "team-c")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rank).To(Equal(-1))
+	})
+
+	It("should reject contributions when RAGContributions is not set", func() {
+		rank, err := GetRAGContributionRank(
+			context.Background(), nil, &apiv1beta1.OpenShiftAILightspeed{}, "team-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rank).To(Equal(-1))
+	})
+
+	It("should reject relative index paths and invalid selectors", func() {
+		contribution := &apiv1beta1.LightspeedRAGContribution{
+			Spec: apiv1beta1.LightspeedRAGContributionSpec{
+				RAG: []apiv1beta1.RAGContributionEntry{
+					{Image: "quay.io/example/rag:latest", IndexPath: "/rag/vector_db/team_a"},
+				},
+			},
+		}
+		Expect(ValidateRAGContribution(contribution)).To(Succeed())
+
+		contribution.Spec.RAG[0].IndexPath = "rag/vector_db/team_a"
+		Expect(ValidateRAGContribution(contribution)).NotTo(Succeed())
+
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				RAGContributions: &apiv1beta1.RAGContributionsSpec{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "team", Operator: "Unknown"},
+						},
+					},
+				},
+			},
+		}
+		Expect(ValidateRAGContributions(instance)).NotTo(Succeed())
+	})
+})
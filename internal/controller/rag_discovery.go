@@ -0,0 +1,366 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for discovering the index metadata inside of the RAG container image.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// RAGImageRefreshInterval - interval after which the RAG image tag is resolved again when
+	// TrackRAGImageUpdates is set
+	RAGImageRefreshInterval = time.Hour
+
+	// ragDiscoveryContainerName - name of the container of the RAG content discovery Job
+	ragDiscoveryContainerName = "rag-discovery"
+
+	// ragDiscoveryScript - script run inside of the RAG container image. Images can announce their layout
+	// with the INDEX_PATH, INDEX_ID and EMBEDDINGS_MODEL environment variables, otherwise it is discovered
+	// from the llama-index vector DB stored under /rag. The results are written to the termination log in
+	// the KEY=value format.
+	ragDiscoveryScript = `index_path="${INDEX_PATH:-}"
+if [ -z "${index_path}" ]; then
+  index_store=$(find /rag -name index_store.json -print 2>/dev/null | head -n 1)
+  [ -n "${index_store}" ] && index_path=$(dirname "${index_store}")
+fi
+index_id="${INDEX_ID:-}"
+if [ -z "${index_id}" ] && [ -f "${index_path}/index_store.json" ]; then
+  index_id=$(grep -o '"index_store/data": *{ *"[^"]*"' "${index_path}/index_store.json" | sed 's/.*"\([^"]*\)"$/\1/')
+fi
+embeddings_model="${EMBEDDINGS_MODEL:-}"
+if [ -z "${embeddings_model}" ]; then
+  embeddings_model=$(find /rag -maxdepth 2 -type d -name 'embeddings_model' -print 2>/dev/null | head -n 1)
+fi
+printf 'INDEX_PATH=%s\nINDEX_ID=%s\nEMBEDDINGS_MODEL=%s\n' "${index_path}" "${index_id}" "${embeddings_model}" > /dev/termination-log
+`
+)
+
+// DiscoverRAGContent runs the OpenShiftAILightspeedJobName Job that discovers the index metadata inside of
+// the RAG container image and resolves the image to a digest, and stores the results in the instance
+// status. The Job is recreated when the RAG image or its pull secrets change or, with TrackRAGImageUpdates,
+// when the digest is due to be resolved again, and removed once the results are stored. A failed Job is kept and a
+// JobFailedError is returned until the RAG image changes, or until the next refresh of a tracked RAG image.
+// Returns true once the metadata of the current RAG image is known, which includes the time the digest is
+// being resolved again.
+func DiscoverRAGContent(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, error) {
+	isDiscovered := instance.Status.RAG != nil && instance.Status.RAG.Image == instance.Spec.RAGImage
+	if isDiscovered && !IsRAGImageRefreshDue(instance, time.Now()) {
+		return true, nil
+	}
+
+	pullSecretsHash, err := GetRAGImagePullSecretsHash(ctx, helper, instance)
+	if err != nil {
+		return isDiscovered, err
+	}
+
+	job := &batchv1.Job{}
+	err = helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      OpenShiftAILightspeedJobName,
+		Namespace: instance.Namespace,
+	}, job)
+	if k8s_errors.IsNotFound(err) {
+		return isDiscovered, CreateRAGDiscoveryJob(ctx, helper, instance, pullSecretsHash)
+	} else if err != nil {
+		return isDiscovered, err
+	}
+
+	// The Job was created for a previous RAG image, or its pod may not be able to pull the RAG image with the
+	// previous pull secrets
+	if len(job.Spec.Template.Spec.Containers) == 0 ||
+		job.Spec.Template.Spec.Containers[0].Image != instance.Spec.RAGImage ||
+		job.GetAnnotations()[OpenShiftAILightspeedPullSecretsHashAnnotation] != pullSecretsHash {
+		return isDiscovered, RemoveRAGDiscoveryJob(ctx, helper, instance)
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			// The refresh of a tracked RAG image is retried once the refresh interval has elapsed again
+			if isDiscovered && !time.Now().Before(job.CreationTimestamp.Add(RAGImageRefreshInterval)) {
+				return isDiscovered, RemoveRAGDiscoveryJob(ctx, helper, instance)
+			}
+			return isDiscovered, &JobFailedError{Job: job.Name, Message: c.Message}
+		}
+	}
+
+	if job.Status.Succeeded == 0 {
+		return isDiscovered, nil
+	}
+
+	var pods corev1.PodList
+	err = helper.GetClient().List(ctx, &pods,
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name},
+	)
+	if err != nil {
+		return isDiscovered, err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name != ragDiscoveryContainerName || containerStatus.State.Terminated == nil {
+				continue
+			}
+
+			ragStatus := ParseRAGDiscoveryOutput(containerStatus.State.Terminated.Message)
+			ragStatus.Image = instance.Spec.RAGImage
+			ragStatus.Digest = GetImageDigest(containerStatus.ImageID)
+			ragStatus.ResolvedAt = &metav1.Time{Time: time.Now()}
+
+			// Without tracking the updates OLS stays pinned to the digest the image was first resolved to
+			if isDiscovered && !instance.Spec.TrackRAGImageUpdates {
+				ragStatus.Digest = instance.Status.RAG.Digest
+			}
+
+			if isDiscovered && ragStatus.Digest != instance.Status.RAG.Digest {
+				helper.GetLogger().Info("RAG image tag moved to a new digest", "Image", ragStatus.Image,
+					"Digest", ragStatus.Digest, "PreviousDigest", instance.Status.RAG.Digest)
+			}
+			instance.Status.RAG = ragStatus
+
+			return true, RemoveRAGDiscoveryJob(ctx, helper, instance)
+		}
+	}
+
+	return isDiscovered, fmt.Errorf("RAG content discovery job succeeded but its results are not available")
+}
+
+// CreateRAGDiscoveryJob creates the Job that discovers the index metadata inside of the RAG container image.
+func CreateRAGDiscoveryJob(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	pullSecretsHash string,
+) error {
+	job := GetRAGDiscoveryJob(instance, pullSecretsHash)
+	err := controllerutil.SetControllerReference(instance, job, helper.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	helper.GetLogger().Info("Creating the RAG content discovery job", "Image", instance.Spec.RAGImage)
+	err = helper.GetClient().Create(ctx, job)
+	if err != nil && !k8s_errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetRAGDiscoveryJob returns the Job that discovers the index metadata inside of the RAG container image. The
+// image is always pulled, so that the digest reported by the pod is the one the tag currently points to.
+// The Job is annotated with pullSecretsHash, see GetRAGImagePullSecretsHash.
+func GetRAGDiscoveryJob(instance *apiv1beta1.OpenShiftAILightspeed, pullSecretsHash string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedJobName,
+			Namespace: instance.Namespace,
+			Annotations: map[string]string{
+				OpenShiftAILightspeedPullSecretsHashAnnotation: pullSecretsHash,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:    corev1.RestartPolicyNever,
+					ImagePullSecrets: GetRAGImagePullSecrets(instance),
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:                     ragDiscoveryContainerName,
+							Image:                    instance.Spec.RAGImage,
+							ImagePullPolicy:          corev1.PullAlways,
+							Command:                  []string{"/bin/sh", "-c", ragDiscoveryScript},
+							TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("10m"),
+									corev1.ResourceMemory: resource.MustParse("32Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// RemoveRAGDiscoveryJob deletes the RAG content discovery Job together with its pods if it exists.
+func RemoveRAGDiscoveryJob(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedJobName,
+			Namespace: instance.Namespace,
+		},
+	}
+
+	err := helper.GetClient().Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// ParseRAGDiscoveryOutput parses the KEY=value lines written by the RAG content discovery Job. Unknown
+// keys and malformed lines are ignored.
+func ParseRAGDiscoveryOutput(output string) *apiv1beta1.RAGStatus {
+	ragStatus := &apiv1beta1.RAGStatus{}
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+
+		switch key {
+		case "INDEX_PATH":
+			ragStatus.IndexPath = value
+		case "INDEX_ID":
+			ragStatus.IndexID = value
+		case "EMBEDDINGS_MODEL":
+			ragStatus.EmbeddingModel = value
+		}
+	}
+
+	return ragStatus
+}
+
+// GetRAGIndexPath returns the path of the vector DB inside of the RAG container image. The discovered path
+// takes precedence over OpenShiftAILightspeedVectorDBPath.
+func GetRAGIndexPath(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Status.RAG != nil && instance.Status.RAG.Image == instance.Spec.RAGImage &&
+		instance.Status.RAG.IndexPath != "" {
+		return instance.Status.RAG.IndexPath
+	}
+
+	return OpenShiftAILightspeedVectorDBPath
+}
+
+// GetRAGIndexID returns the ID of the index discovered in the RAG container image or an empty string when
+// it is not known.
+func GetRAGIndexID(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Status.RAG != nil && instance.Status.RAG.Image == instance.Spec.RAGImage {
+		return instance.Status.RAG.IndexID
+	}
+
+	return ""
+}
+
+// GetRAGImage returns the RAG image OLS uses. It is the repository of the RAG image pinned to the digest
+// the image was resolved to, or the RAG image itself while the digest is not known.
+func GetRAGImage(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	if instance.Status.RAG != nil && instance.Status.RAG.Image == instance.Spec.RAGImage &&
+		instance.Status.RAG.Digest != "" {
+		return GetImageRepository(instance.Spec.RAGImage) + "@" + instance.Status.RAG.Digest
+	}
+
+	return instance.Spec.RAGImage
+}
+
+// GetImageRepository returns the image reference without its tag and digest.
+func GetImageRepository(image string) string {
+	repository, _, _ := strings.Cut(image, "@")
+
+	// A colon after the last slash separates the tag, other colons separate the registry port
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+
+	return repository
+}
+
+// GetImageDigest returns the digest of the image ID reported in a container status (e.g.
+// quay.io/org/image@sha256:...) or an empty string when it does not contain one.
+func GetImageDigest(imageID string) string {
+	_, digest, found := strings.Cut(imageID, "@")
+	if !found {
+		return ""
+	}
+
+	return digest
+}
+
+// IsRAGImageRefreshDue returns true if TrackRAGImageUpdates is set and the RAG image tag was resolved at
+// least RAGImageRefreshInterval ago.
+func IsRAGImageRefreshDue(instance *apiv1beta1.OpenShiftAILightspeed, now time.Time) bool {
+	if !instance.Spec.TrackRAGImageUpdates || instance.Status.RAG == nil {
+		return false
+	}
+
+	if instance.Status.RAG.ResolvedAt == nil {
+		return true
+	}
+
+	return !now.Before(instance.Status.RAG.ResolvedAt.Add(RAGImageRefreshInterval))
+}
+
+// GetRAGImageRefreshDelay returns the duration after which the RAG image tag has to be resolved again, or
+// 0 when TrackRAGImageUpdates is not set. A refresh that is already due is retried after a minute.
+func GetRAGImageRefreshDelay(instance *apiv1beta1.OpenShiftAILightspeed, now time.Time) time.Duration {
+	if !instance.Spec.TrackRAGImageUpdates || instance.Status.RAG == nil {
+		return 0
+	}
+
+	if IsRAGImageRefreshDue(instance, now) {
+		return time.Minute
+	}
+
+	return instance.Status.RAG.ResolvedAt.Add(RAGImageRefreshInterval).Sub(now)
+}
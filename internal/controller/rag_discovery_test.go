@@ -0,0 +1,92 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("RAG content discovery", func() {
+	It("should parse the discovered index metadata", func() {
+		output := "INDEX_PATH=/rag/vector_db/custom\nINDEX_ID=custom-docs\nEMBEDDINGS_MODEL=/rag/embeddings_model\n"
+		Expect(ParseRAGDiscoveryOutput(output)).To(Equal(&apiv1beta1.RAGStatus{
+			IndexPath:      "/rag/vector_db/custom",
+			IndexID:        "custom-docs",
+			EmbeddingModel: "/rag/embeddings_model",
+		}))
+	})
+
+	It("should ignore empty values and unknown keys", func() {
+		output := "INDEX_PATH=/rag/vector_db/custom\nINDEX_ID=\nUNKNOWN=value\nmalformed\n"
+		Expect(ParseRAGDiscoveryOutput(output)).To(Equal(&apiv1beta1.RAGStatus{
+			IndexPath: "/rag/vector_db/custom",
+		}))
+	})
+
+	It("should fall back to the default index path for a different image", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.RAGImage = "quay.io/example/rag:new"
+		instance.Status.RAG = &apiv1beta1.RAGStatus{
+			Image:     "quay.io/example/rag:old",
+			IndexPath: "/rag/vector_db/custom",
+		}
+		Expect(GetRAGIndexPath(instance)).To(Equal(OpenShiftAILightspeedVectorDBPath))
+	})
+
+	It("should strip the tag and the digest from image references", func() {
+		Expect(GetImageRepository("quay.io/example/rag:rhoai-docs-2.22")).To(Equal("quay.io/example/rag"))
+		Expect(GetImageRepository("registry.local:5000/example/rag")).To(Equal("registry.local:5000/example/rag"))
+		Expect(GetImageRepository("registry.local:5000/example/rag:v1@sha256:abc")).To(
+			Equal("registry.local:5000/example/rag"))
+	})
+
+	It("should pin the RAG image to the resolved digest", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.RAGImage = "quay.io/example/rag:latest"
+		Expect(GetRAGImage(instance)).To(Equal("quay.io/example/rag:latest"))
+
+		instance.Status.RAG = &apiv1beta1.RAGStatus{
+			Image:  "quay.io/example/rag:latest",
+			Digest: GetImageDigest("quay.io/mirror/rag@sha256:abc"),
+		}
+		Expect(GetRAGImage(instance)).To(Equal("quay.io/example/rag@sha256:abc"))
+	})
+
+	It("should always pull the RAG image in the discovery job", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Namespace = "test-namespace"
+		instance.Spec.RAGImage = "quay.io/example/rag:latest"
+
+		job := GetRAGDiscoveryJob(instance, "0123456789abcdef")
+		Expect(job.Name).To(Equal(OpenShiftAILightspeedJobName))
+		Expect(job.Namespace).To(Equal("test-namespace"))
+		Expect(job.Annotations).To(HaveKeyWithValue(OpenShiftAILightspeedPullSecretsHashAnnotation,
+			"0123456789abcdef"))
+		Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+
+		container := job.Spec.Template.Spec.Containers[0]
+		Expect(container.Name).To(Equal(ragDiscoveryContainerName))
+		Expect(container.Image).To(Equal("quay.io/example/rag:latest"))
+		Expect(container.ImagePullPolicy).To(Equal(corev1.PullAlways))
+		Expect(container.TerminationMessagePolicy).To(Equal(corev1.TerminationMessageReadFile))
+	})
+})
@@ -0,0 +1,394 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for indexing the documents referenced in LightspeedRAGSources into RAG images
+// and for adding the RAG images to the OLSConfig.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// LightspeedRAGSourcePrefix - prefix of the names of the Job, BuildConfig, ImageStream, ServiceAccount,
+	// Role and RoleBinding created for a LightspeedRAGSource
+	LightspeedRAGSourcePrefix = "lightspeed-rag-source-"
+
+	// LightspeedRAGSourceHashAnnotation - annotation on the indexing Job that holds the hash of the
+	// LightspeedRAGSource spec it indexes
+	LightspeedRAGSourceHashAnnotation = "openshift-ai.io/rag-source-spec-hash"
+
+	// ragIndexingBuildContainerName - name of the container of the indexing Job that builds the RAG image
+	ragIndexingBuildContainerName = "build"
+
+	// ragIndexingVectorDBPath - parent path of the vector DBs inside of the indexed RAG images
+	ragIndexingVectorDBPath = "/rag/vector_db"
+
+	// ragIndexingGitScript - script cloning the Git repository into /staging/docs
+	ragIndexingGitScript = `set -e
+if [ -n "${GIT_USERNAME}" ]; then
+  git config --global credential.helper '!f() { echo "username=${GIT_USERNAME}"; echo "password=${GIT_PASSWORD}"; }; f'
+fi
+git clone --depth 1 ${GIT_REF:+--branch "${GIT_REF}"} "${GIT_URL}" /staging/repo
+mkdir -p /staging/docs
+cp -r "/staging/repo/${SOURCE_PATH#/}/." /staging/docs
+`
+)
+
+// EnsureRAGSourceIndex indexes the documents referenced in the LightspeedRAGSource into a RAG image and
+// stores the image in the status. The documents are indexed again when the spec changes. The previously
+// indexed image stays in use until the new one is built. Returns true once the image of the current spec
+// is known. A failed indexing Job is kept and a JobFailedError is returned until the spec changes.
+func EnsureRAGSourceIndex(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	source *apiv1beta1.LightspeedRAGSource,
+) (bool, error) {
+	sourceHash, err := GetSpecHash(source.Spec)
+	if err != nil {
+		return false, err
+	}
+
+	if source.Status.Image != "" && source.Status.SourceHash == sourceHash {
+		return true, nil
+	}
+
+	name := GetRAGSourceResourceName(source)
+	err = EnsureRAGBuild(ctx, helper, source, name, GetRAGSourceIndexPath(source))
+	if err != nil {
+		return false, err
+	}
+
+	err = EnsureRAGBuildAccess(ctx, helper, source, name, name)
+	if err != nil {
+		return false, err
+	}
+
+	job := &batchv1.Job{}
+	err = helper.GetClient().Get(ctx, client.ObjectKey{Name: name, Namespace: source.Namespace}, job)
+	if k8s_errors.IsNotFound(err) {
+		return false, CreateRAGIndexingJob(ctx, helper, source, sourceHash)
+	} else if err != nil {
+		return false, err
+	}
+
+	// The Job was created for a previous spec
+	if job.GetAnnotations()[LightspeedRAGSourceHashAnnotation] != sourceHash {
+		return false, RemoveRAGIndexingJob(ctx, helper, source)
+	}
+
+	buildName, err := GetRAGBuildJobResult(ctx, helper, job, ragIndexingBuildContainerName)
+	if err != nil {
+		// The failed Job is kept until the spec changes
+		return false, &JobFailedError{Job: job.Name, Message: err.Error()}
+	} else if buildName == "" {
+		return false, nil
+	}
+
+	image, err := GetBuildOutputImage(ctx, helper, source.Namespace, buildName)
+	if err != nil {
+		return false, err
+	}
+
+	source.Status.Image = image
+	source.Status.IndexPath = GetRAGSourceIndexPath(source)
+	source.Status.IndexID = GetRAGSourceIndexID(source)
+	source.Status.SourceHash = sourceHash
+	source.Status.IndexedAt = &metav1.Time{Time: time.Now()}
+
+	return true, RemoveRAGIndexingJob(ctx, helper, source)
+}
+
+// CreateRAGIndexingJob creates the Job that fetches the documents, indexes them with the indexer image and
+// builds the vector DB into a RAG image. The indexer image is run with its default entrypoint and reads the
+// documents from DOCS_DIR and writes the vector DB to OUTPUT_DIR.
+func CreateRAGIndexingJob(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	source *apiv1beta1.LightspeedRAGSource,
+	sourceHash string,
+) error {
+	name := GetRAGSourceResourceName(source)
+
+	volumes := []corev1.Volume{
+		{
+			Name:         "staging",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	}
+	stagingMount := corev1.VolumeMount{Name: "staging", MountPath: "/staging"}
+	indexMounts := []corev1.VolumeMount{stagingMount}
+
+	var initContainers []corev1.Container
+	var docsDir string
+	switch {
+	case source.Spec.Git != nil:
+		docsDir = "/staging/docs"
+		env := []corev1.EnvVar{
+			{Name: "HOME", Value: "/staging"},
+			{Name: "GIT_URL", Value: source.Spec.Git.URL},
+			{Name: "GIT_REF", Value: source.Spec.Git.Ref},
+			{Name: "SOURCE_PATH", Value: source.Spec.Git.Path},
+		}
+		if source.Spec.Git.SecretName != "" {
+			env = append(env,
+				GetSecretKeyEnvVar("GIT_USERNAME", source.Spec.Git.SecretName, corev1.BasicAuthUsernameKey),
+				GetSecretKeyEnvVar("GIT_PASSWORD", source.Spec.Git.SecretName, corev1.BasicAuthPasswordKey),
+			)
+		}
+		initContainers = append(initContainers, GetRAGIndexingContainer(
+			"fetch", source.Spec.IndexerImage, []string{"/bin/sh", "-c", ragIndexingGitScript}, env,
+			[]corev1.VolumeMount{stagingMount}))
+
+	case source.Spec.ConfigMap != nil:
+		docsDir = "/source"
+		volumes = append(volumes, corev1.Volume{
+			Name: "source",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: source.Spec.ConfigMap.Name},
+				},
+			},
+		})
+		indexMounts = append(indexMounts, corev1.VolumeMount{Name: "source", MountPath: "/source", ReadOnly: true})
+
+	case source.Spec.PVC != nil:
+		docsDir = path.Join("/source", source.Spec.PVC.Path)
+		volumes = append(volumes, corev1.Volume{
+			Name: "source",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: source.Spec.PVC.ClaimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		indexMounts = append(indexMounts, corev1.VolumeMount{Name: "source", MountPath: "/source", ReadOnly: true})
+	}
+
+	initContainers = append(initContainers, GetRAGIndexingContainer(
+		"index", source.Spec.IndexerImage, nil, []corev1.EnvVar{
+			{Name: "HOME", Value: "/staging"},
+			{Name: "DOCS_DIR", Value: docsDir},
+			{Name: "OUTPUT_DIR", Value: "/staging/content"},
+			{Name: "INDEX_ID", Value: GetRAGSourceIndexID(source)},
+			{Name: "EMBEDDING_MODEL", Value: source.Spec.EmbeddingModel},
+		}, indexMounts))
+
+	buildContainer := GetRAGIndexingContainer(
+		ragIndexingBuildContainerName, apiv1beta1.OpenShiftAILightspeedDefaultValues.CLIImageURL,
+		[]string{"/bin/sh", "-c", "set -e\ncontent=/staging/content\n" + ragBuildScript},
+		[]corev1.EnvVar{{Name: "BUILD_CONFIG", Value: name}},
+		[]corev1.VolumeMount{stagingMount})
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: source.Namespace,
+			Annotations: map[string]string{
+				LightspeedRAGSourceHashAnnotation: sourceHash,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: name,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Volumes:        volumes,
+					InitContainers: initContainers,
+					Containers:     []corev1.Container{buildContainer},
+				},
+			},
+		},
+	}
+
+	err := controllerutil.SetControllerReference(source, job, helper.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	helper.GetLogger().Info("Creating the indexing job", "LightspeedRAGSource", source.Name)
+	err = helper.GetClient().Create(ctx, job)
+	if err != nil && !k8s_errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetRAGIndexingContainer returns a container of the indexing Job. The indexer may download the embedding
+// model, so the containers get more memory than the other Jobs of the operator.
+func GetRAGIndexingContainer(
+	name string,
+	image string,
+	command []string,
+	env []corev1.EnvVar,
+	volumeMounts []corev1.VolumeMount,
+) corev1.Container {
+	return corev1.Container{
+		Name:                     name,
+		Image:                    image,
+		Command:                  command,
+		Env:                      env,
+		VolumeMounts:             volumeMounts,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+}
+
+// GetSecretKeyEnvVar returns an environment variable set from the given key of a secret.
+func GetSecretKeyEnvVar(name string, secretName string, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+// RemoveRAGIndexingJob deletes the indexing Job of the LightspeedRAGSource together with its pods if it
+// exists.
+func RemoveRAGIndexingJob(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	source *apiv1beta1.LightspeedRAGSource,
+) error {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetRAGSourceResourceName(source),
+			Namespace: source.Namespace,
+		},
+	}
+
+	err := helper.GetClient().Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateLightspeedRAGSource validates the parts of the LightspeedRAGSource spec that cannot be expressed
+// via the CRD schema.
+func ValidateLightspeedRAGSource(source *apiv1beta1.LightspeedRAGSource) error {
+	if source.Spec.Git != nil && !path.IsAbs(source.Spec.Git.Path) {
+		return fmt.Errorf("git path %q must be absolute", source.Spec.Git.Path)
+	}
+
+	if source.Spec.PVC != nil && !path.IsAbs(source.Spec.PVC.Path) {
+		return fmt.Errorf("pvc path %q must be absolute", source.Spec.PVC.Path)
+	}
+
+	if strings.ContainsAny(GetRAGSourceIndexID(source), "/ ") {
+		return fmt.Errorf("indexID %q must not contain slashes or spaces", GetRAGSourceIndexID(source))
+	}
+
+	return nil
+}
+
+// GetRAGSourceResourceName returns the name of the objects created for the LightspeedRAGSource.
+func GetRAGSourceResourceName(source *apiv1beta1.LightspeedRAGSource) string {
+	return LightspeedRAGSourcePrefix + source.Name
+}
+
+// GetRAGSourceIndexPath returns the path of the vector DB inside of the RAG image of the LightspeedRAGSource.
+func GetRAGSourceIndexPath(source *apiv1beta1.LightspeedRAGSource) string {
+	return path.Join(ragIndexingVectorDBPath, source.Name)
+}
+
+// GetRAGSourceIndexID returns the ID of the index of the LightspeedRAGSource, which defaults to its name.
+func GetRAGSourceIndexID(source *apiv1beta1.LightspeedRAGSource) string {
+	if source.Spec.IndexID != "" {
+		return source.Spec.IndexID
+	}
+
+	return source.Name
+}
+
+// GetAdditionalRAG returns the RAG images indexed from the LightspeedRAGSources in the namespace of the
+// instance, ordered by the name of the LightspeedRAGSource. A LightspeedRAGSource being indexed again
+// contributes its previously indexed image.
+func GetAdditionalRAG(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) ([]apiv1beta1.AdditionalRAGStatus, error) {
+	var sources apiv1beta1.LightspeedRAGSourceList
+	err := helper.GetClient().List(ctx, &sources, client.InNamespace(instance.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	var additionalRAG []apiv1beta1.AdditionalRAGStatus
+	for _, source := range sources.Items {
+		if source.Status.Image == "" || !source.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		additionalRAG = append(additionalRAG, apiv1beta1.AdditionalRAGStatus{
+			Source:    source.Name,
+			Image:     source.Status.Image,
+			IndexPath: source.Status.IndexPath,
+			IndexID:   source.Status.IndexID,
+		})
+	}
+
+	slices.SortFunc(additionalRAG, func(a, b apiv1beta1.AdditionalRAGStatus) int {
+		return strings.Compare(a.Source, b.Source)
+	})
+
+	return additionalRAG, nil
+}
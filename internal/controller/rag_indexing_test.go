@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RAG source indexing", func() {
+	source := func(spec apiv1beta1.LightspeedRAGSourceSpec) *apiv1beta1.LightspeedRAGSource {
+		return &apiv1beta1.LightspeedRAGSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "runbooks", Namespace: "openshift-lightspeed"},
+			Spec:       spec,
+		}
+	}
+
+	It("should default the index ID to the name of the source", func() {
+		Expect(GetRAGSourceIndexID(source(apiv1beta1.LightspeedRAGSourceSpec{}))).To(Equal("runbooks"))
+		Expect(GetRAGSourceIndexID(source(apiv1beta1.LightspeedRAGSourceSpec{IndexID: "ops"}))).To(Equal("ops"))
+		Expect(GetRAGSourceIndexPath(source(apiv1beta1.LightspeedRAGSourceSpec{}))).To(
+			Equal("/rag/vector_db/runbooks"))
+	})
+
+	It("should reject relative paths and invalid index IDs", func() {
+		Expect(ValidateLightspeedRAGSource(source(apiv1beta1.LightspeedRAGSourceSpec{
+			Git: &apiv1beta1.GitDocumentSource{URL: "https://example.com/runbooks.git", Path: "/docs"},
+		}))).To(Succeed())
+		Expect(ValidateLightspeedRAGSource(source(apiv1beta1.LightspeedRAGSourceSpec{
+			Git: &apiv1beta1.GitDocumentSource{URL: "https://example.com/runbooks.git", Path: "docs"},
+		}))).NotTo(Succeed())
+		Expect(ValidateLightspeedRAGSource(source(apiv1beta1.LightspeedRAGSourceSpec{
+			ConfigMap: &apiv1beta1.ConfigMapDocumentSource{Name: "runbooks"},
+			IndexID:   "ops/runbooks",
+		}))).NotTo(Succeed())
+	})
+})
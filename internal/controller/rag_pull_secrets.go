@@ -0,0 +1,257 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for pulling the RAG images from private registries.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// OpenShiftAILightspeedPullSecretPrefix - prefix of the names of the pull secrets copied into the
+	// namespace of the instance
+	OpenShiftAILightspeedPullSecretPrefix = "openshift-ai-lightspeed-pull-"
+
+	// OpenShiftAILightspeedPullSecretLabel - label of the pull secrets copied into the namespace of the
+	// instance
+	OpenShiftAILightspeedPullSecretLabel = "openshift-ai.io/lightspeed-pull-secret"
+
+	// OpenShiftAILightspeedPullSecretsHashAnnotation - annotation on the RAG content discovery Job that holds
+	// the hash of the pull secrets its pod was created with
+	OpenShiftAILightspeedPullSecretsHashAnnotation = "openshift-ai.io/pull-secrets-hash"
+)
+
+// imagePullFailureReasons - reasons of waiting containers whose image cannot be pulled
+var imagePullFailureReasons = []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName"}
+
+// EnsureRAGImagePullSecrets validates the secrets listed in RAGImagePullSecrets and copies the ones from
+// other namespaces into the namespace of the instance. Copies of secrets that are not listed anymore are
+// removed.
+func EnsureRAGImagePullSecrets(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	var copiedSecretNames []string
+	var err error
+	for _, ref := range instance.Spec.RAGImagePullSecrets {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = instance.Namespace
+		}
+
+		// The pull secrets may live outside of WATCH_NAMESPACE
+		secret := &corev1.Secret{}
+		err = reader.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, secret)
+		if err != nil {
+			return err
+		}
+
+		err = ValidatePullSecret(secret)
+		if err != nil {
+			return err
+		}
+
+		if namespace == instance.Namespace {
+			continue
+		}
+
+		copiedSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GetRAGImagePullSecretName(instance, ref),
+				Namespace: instance.Namespace,
+			},
+		}
+		_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), copiedSecret, func() error {
+			labels := copiedSecret.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[OpenShiftAILightspeedPullSecretLabel] = "true"
+			copiedSecret.SetLabels(labels)
+
+			copiedSecret.Type = secret.Type
+			copiedSecret.Data = secret.Data
+
+			return controllerutil.SetControllerReference(instance, copiedSecret, helper.GetScheme())
+		})
+		if err != nil {
+			return err
+		}
+		copiedSecretNames = append(copiedSecretNames, copiedSecret.Name)
+	}
+
+	var copiedSecrets corev1.SecretList
+	err = helper.GetClient().List(ctx, &copiedSecrets,
+		client.InNamespace(instance.Namespace),
+		client.MatchingLabels{OpenShiftAILightspeedPullSecretLabel: "true"},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, copiedSecret := range copiedSecrets.Items {
+		if slices.Contains(copiedSecretNames, copiedSecret.Name) || !IsOwnedBy(&copiedSecret, instance) {
+			continue
+		}
+
+		err = helper.GetClient().Delete(ctx, &copiedSecret)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidatePullSecret returns an error if the secret does not hold registry credentials.
+func ValidatePullSecret(secret *corev1.Secret) error {
+	var key string
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		key = corev1.DockerConfigJsonKey
+	case corev1.SecretTypeDockercfg:
+		key = corev1.DockerConfigKey
+	default:
+		return fmt.Errorf("secret %s/%s is of type %s, expected %s", secret.Namespace, secret.Name,
+			secret.Type, corev1.SecretTypeDockerConfigJson)
+	}
+
+	if len(secret.Data[key]) == 0 {
+		return fmt.Errorf("secret %s/%s has no %s key", secret.Namespace, secret.Name, key)
+	}
+
+	return nil
+}
+
+// GetRAGImagePullSecretName returns the name of the pull secret in the namespace of the instance. Secrets
+// from other namespaces are referenced by the name of their copy.
+func GetRAGImagePullSecretName(instance *apiv1beta1.OpenShiftAILightspeed, ref apiv1beta1.SecretReference) string {
+	if ref.Namespace == "" || ref.Namespace == instance.Namespace {
+		return ref.Name
+	}
+
+	return fmt.Sprintf("%s%s-%s", OpenShiftAILightspeedPullSecretPrefix, ref.Namespace, ref.Name)
+}
+
+// GetRAGImagePullSecrets returns the pull secrets of the RAG images as references in the namespace of the
+// instance.
+func GetRAGImagePullSecrets(instance *apiv1beta1.OpenShiftAILightspeed) []corev1.LocalObjectReference {
+	var pullSecrets []corev1.LocalObjectReference
+	for _, ref := range instance.Spec.RAGImagePullSecrets {
+		pullSecrets = append(pullSecrets, corev1.LocalObjectReference{
+			Name: GetRAGImagePullSecretName(instance, ref),
+		})
+	}
+
+	return pullSecrets
+}
+
+// GetRAGImagePullSecretsHash returns the hash of the names and the resource versions of the pull secrets of the
+// RAG images in the namespace of the instance, which includes the copies of the secrets from other
+// namespaces. It changes when the pull secrets are replaced or their credentials are updated.
+func GetRAGImagePullSecretsHash(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (string, error) {
+	var pullSecrets []string
+	for _, ref := range GetRAGImagePullSecrets(instance) {
+		secret := &corev1.Secret{}
+		err := helper.GetClient().Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: instance.Namespace}, secret)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return "", err
+		}
+		pullSecrets = append(pullSecrets, ref.Name+"@"+secret.ResourceVersion)
+	}
+
+	return GetSpecHash(pullSecrets)
+}
+
+// IsReferencedRAGImagePullSecret returns true if the instance pulls the RAG images with the secret with the
+// given name and namespace.
+func IsReferencedRAGImagePullSecret(instance *apiv1beta1.OpenShiftAILightspeed, name string, namespace string) bool {
+	for _, ref := range instance.Spec.RAGImagePullSecrets {
+		refNamespace := ref.Namespace
+		if refNamespace == "" {
+			refNamespace = instance.Namespace
+		}
+
+		if ref.Name == name && refNamespace == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRAGImagePullFailure returns a message describing why a pod in the namespace of the instance cannot
+// pull one of the RAG images, or an empty string when no pull fails.
+func GetRAGImagePullFailure(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (string, error) {
+	var pods corev1.PodList
+	err := helper.GetClient().List(ctx, &pods, client.InNamespace(instance.Namespace))
+	if err != nil {
+		return "", err
+	}
+
+	return GetImagePullFailure(pods.Items, GetRAGImages(instance)), nil
+}
+
+// GetRAGImages returns the references of the effective RAG image of the instance, as set in the spec once
+// it has been selected, staged or updated, and as pinned to its digest.
+func GetRAGImages(instance *apiv1beta1.OpenShiftAILightspeed) []string {
+	if ragImage := GetRAGImage(instance); ragImage != instance.Spec.RAGImage {
+		return []string{instance.Spec.RAGImage, ragImage}
+	}
+
+	return []string{instance.Spec.RAGImage}
+}
+
+// GetImagePullFailure returns a message describing why one of the given pods cannot pull one of the given
+// images, or an empty string when no pull fails.
+func GetImagePullFailure(pods []corev1.Pod, images []string) string {
+	for _, pod := range pods {
+		containerStatuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, containerStatus := range containerStatuses {
+			if !slices.Contains(images, containerStatus.Image) || containerStatus.State.Waiting == nil {
+				continue
+			}
+
+			waiting := containerStatus.State.Waiting
+			if slices.Contains(imagePullFailureReasons, waiting.Reason) {
+				return fmt.Sprintf("pod %s: %s: %s", pod.Name, waiting.Reason, waiting.Message)
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("RAG image pull failures", func() {
+	pod := func(image string, reason string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "lightspeed-app-server"},
+			Status: corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{
+					{
+						Image: image,
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: "unauthorized"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should report pods failing to pull a RAG image", func() {
+		pods := []corev1.Pod{pod("quay.io/example/rag:latest", "ImagePullBackOff")}
+		Expect(GetImagePullFailure(pods, []string{"quay.io/example/rag:latest"})).To(
+			Equal("pod lightspeed-app-server: ImagePullBackOff: unauthorized"))
+	})
+
+	It("should ignore other images and other waiting reasons", func() {
+		pods := []corev1.Pod{
+			pod("quay.io/example/other:latest", "ImagePullBackOff"),
+			pod("quay.io/example/rag:latest", "PodInitializing"),
+		}
+		Expect(GetImagePullFailure(pods, []string{"quay.io/example/rag:latest"})).To(BeEmpty())
+	})
+
+	It("should return the effective RAG image and its digest pinned reference", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.RAGImage = "quay.io/example/rag:latest"
+		Expect(GetRAGImages(instance)).To(Equal([]string{"quay.io/example/rag:latest"}))
+
+		instance.Status.RAG = &apiv1beta1.RAGStatus{Image: "quay.io/example/rag:latest", Digest: "sha256:abc"}
+		Expect(GetRAGImages(instance)).To(Equal([]string{
+			"quay.io/example/rag:latest",
+			"quay.io/example/rag@sha256:abc",
+		}))
+	})
+
+	It("should match the pods against the RAG images in the status", func() {
+		// The RAG image is staged by the operator, so it is not set in the spec
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "openshift-lightspeed"
+		instance.Status.RAGImages = []string{"image-registry.openshift-image-registry.svc:5000/ns/rag@sha256:abc"}
+
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1beta1.AddToScheme(scheme)).To(Succeed())
+		reconciler := &OpenShiftAILightspeedReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).Build(),
+		}
+
+		failingPod := pod(instance.Status.RAGImages[0], "ImagePullBackOff")
+		failingPod.Namespace = instance.Namespace
+		Expect(reconciler.IsRAGImagePullFailing(&failingPod)).To(BeTrue())
+
+		otherPod := pod("quay.io/example/other:latest", "ImagePullBackOff")
+		otherPod.Namespace = instance.Namespace
+		Expect(reconciler.IsRAGImagePullFailing(&otherPod)).To(BeFalse())
+
+		failingPod.Namespace = "other"
+		Expect(reconciler.IsRAGImagePullFailing(&failingPod)).To(BeFalse())
+	})
+
+	It("should change the pull secrets hash when the credentials are updated", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "openshift-lightspeed"
+		instance.Spec.RAGImagePullSecrets = []apiv1beta1.SecretReference{{Name: "registry-credentials"}}
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: "openshift-lightspeed"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1beta1.AddToScheme(scheme)).To(Succeed())
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance, secret).Build()
+		helper, err := common_helper.NewHelper(instance, fakeClient, nil, scheme, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		hash, err := GetRAGImagePullSecretsHash(context.Background(), helper, instance)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"quay.io":{}}}`)
+		Expect(fakeClient.Update(context.Background(), secret)).To(Succeed())
+		updatedHash, err := GetRAGImagePullSecretsHash(context.Background(), helper, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updatedHash).NotTo(Equal(hash))
+
+		instance.Spec.RAGImagePullSecrets = nil
+		noSecretsHash, err := GetRAGImagePullSecretsHash(context.Background(), helper, instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(noSecretsHash).NotTo(Equal(updatedHash))
+	})
+
+	It("should match the referenced pull secrets", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Namespace = "openshift-lightspeed"
+		instance.Spec.RAGImagePullSecrets = []apiv1beta1.SecretReference{
+			{Name: "registry-credentials"},
+			{Name: "docs-credentials", Namespace: "docs"},
+		}
+
+		Expect(IsReferencedRAGImagePullSecret(instance, "registry-credentials", "openshift-lightspeed")).To(BeTrue())
+		Expect(IsReferencedRAGImagePullSecret(instance, "docs-credentials", "docs")).To(BeTrue())
+		Expect(IsReferencedRAGImagePullSecret(instance, "docs-credentials", "openshift-lightspeed")).To(BeFalse())
+	})
+})
@@ -0,0 +1,649 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for staging RAG content stored in a PersistentVolumeClaim or an OCI artifact
+// into a RAG image that OLS can consume.
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// OpenShiftAILightspeedRAGStagingName - name of the Job that stages the RAG source and of the
+	// ServiceAccount, Role and RoleBinding it runs with
+	OpenShiftAILightspeedRAGStagingName = "openshift-ai-lightspeed-rag-staging"
+
+	// OpenShiftAILightspeedRAGBuildName - name of the BuildConfig and the ImageStream of the RAG image staged
+	// from the RAG source
+	OpenShiftAILightspeedRAGBuildName = "openshift-ai-lightspeed-rag"
+
+	// OpenShiftAILightspeedRAGSourceHashAnnotation - annotation on the staging Job that holds the hash of the
+	// RAG source it stages
+	OpenShiftAILightspeedRAGSourceHashAnnotation = "openshift-ai.io/rag-source-hash"
+
+	// ragSourceIndexPath - path of the vector DB inside of the staged RAG image
+	ragSourceIndexPath = "/rag/vector_db/rag_source"
+
+	// ragStagingContainerName - name of the container of the staging Job
+	ragStagingContainerName = "rag-staging"
+
+	// ragStagingPullSecretPath - path the pull secret for the OCI artifact is mounted at
+	ragStagingPullSecretPath = "/etc/rag-pull-secret"
+
+	// ragStagingScript - script run by the staging Job. The content is copied from the PVC mounted at /source
+	// or extracted from the OCI artifact before ragBuildScript builds it into the RAG image.
+	ragStagingScript = `set -e
+content=/staging/content
+mkdir -p "${content}"
+if [ -n "${OCI_ARTIFACT}" ]; then
+  registry_config=""
+  [ -f ` + ragStagingPullSecretPath + `/.dockerconfigjson ] && registry_config="--registry-config=` +
+		ragStagingPullSecretPath + `/.dockerconfigjson"
+  oc image extract "${OCI_ARTIFACT}" ${registry_config} --path "${SOURCE_PATH%/}/:${content}" --confirm
+else
+  cp -r "/source/${SOURCE_PATH#/}/." "${content}"
+fi
+` + ragBuildScript
+
+	// ragBuildScript - script that validates that the directory in ${content} contains a llama-index vector
+	// DB and builds it into the RAG image with the ${BUILD_CONFIG} BuildConfig. The name of the build is
+	// written to the termination log in the KEY=value format.
+	ragBuildScript = `if ! find "${content}" -name index_store.json | grep -q .; then
+  echo "no llama-index vector DB (index_store.json) found" > /dev/termination-log
+  exit 1
+fi
+build=$(oc start-build "${BUILD_CONFIG}" --from-dir="${content}" --wait -o name)
+printf 'BUILD=%s\n' "${build##*/}" > /dev/termination-log
+`
+)
+
+var (
+	// BuildConfigGVK - GroupVersionKind of OpenShift BuildConfigs
+	BuildConfigGVK = schema.GroupVersionKind{Group: "build.openshift.io", Version: "v1", Kind: "BuildConfig"}
+
+	// BuildGVK - GroupVersionKind of OpenShift Builds
+	BuildGVK = schema.GroupVersionKind{Group: "build.openshift.io", Version: "v1", Kind: "Build"}
+
+	// ImageStreamGVK - GroupVersionKind of OpenShift ImageStreams
+	ImageStreamGVK = schema.GroupVersionKind{Group: "image.openshift.io", Version: "v1", Kind: "ImageStream"}
+)
+
+// EnsureRAGSource stages the content referenced in RAGSource into a RAG image and stores the image in the
+// instance status. The content is staged again when RAGSource changes. The previously staged image stays in
+// use until the new content is validated and built. Returns true once the image of the current RAGSource
+// is known. A failed staging Job is kept and a JobFailedError is returned until RAGSource changes.
+func EnsureRAGSource(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, error) {
+	sourceHash, err := GetSpecHash(instance.Spec.RAGSource)
+	if err != nil {
+		return false, err
+	}
+
+	if instance.Status.RAGSource != nil && instance.Status.RAGSource.SourceHash == sourceHash {
+		return true, nil
+	}
+
+	err = EnsureRAGBuild(ctx, helper, instance, OpenShiftAILightspeedRAGBuildName, ragSourceIndexPath)
+	if err != nil {
+		return false, err
+	}
+
+	err = EnsureRAGBuildAccess(ctx, helper, instance, OpenShiftAILightspeedRAGStagingName,
+		OpenShiftAILightspeedRAGBuildName)
+	if err != nil {
+		return false, err
+	}
+
+	job := &batchv1.Job{}
+	err = helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      OpenShiftAILightspeedRAGStagingName,
+		Namespace: instance.Namespace,
+	}, job)
+	if k8s_errors.IsNotFound(err) {
+		return false, CreateRAGStagingJob(ctx, helper, instance, sourceHash)
+	} else if err != nil {
+		return false, err
+	}
+
+	// The Job was created for a previous RAG source
+	if job.GetAnnotations()[OpenShiftAILightspeedRAGSourceHashAnnotation] != sourceHash {
+		return false, RemoveRAGStagingJob(ctx, helper, instance)
+	}
+
+	buildName, err := GetRAGBuildJobResult(ctx, helper, job, ragStagingContainerName)
+	if err != nil {
+		// The failed Job is kept until RAGSource changes
+		return false, &JobFailedError{Job: job.Name, Message: err.Error()}
+	} else if buildName == "" {
+		return false, nil
+	}
+
+	image, err := GetBuildOutputImage(ctx, helper, instance.Namespace, buildName)
+	if err != nil {
+		return false, err
+	}
+
+	instance.Status.RAGSource = &apiv1beta1.RAGSourceStatus{
+		Image:      image,
+		SourceHash: sourceHash,
+	}
+
+	return true, RemoveRAGStagingJob(ctx, helper, instance)
+}
+
+// EnsureRAGBuild creates or updates the ImageStream and the binary BuildConfig named buildName that build
+// a vector DB into a RAG image. The vector DB is copied to indexPath on top of the RAG base image.
+func EnsureRAGBuild(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	owner client.Object,
+	buildName string,
+	indexPath string,
+) error {
+	imageStream := &uns.Unstructured{}
+	imageStream.SetGroupVersionKind(ImageStreamGVK)
+	imageStream.SetName(buildName)
+	imageStream.SetNamespace(owner.GetNamespace())
+
+	_, err := controllerutil.CreateOrPatch(ctx, helper.GetClient(), imageStream, func() error {
+		return controllerutil.SetControllerReference(owner, imageStream, helper.GetScheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	dockerfile := fmt.Sprintf("FROM %s\nCOPY . %s\n",
+		apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGBaseImageURL, indexPath)
+
+	buildConfig := &uns.Unstructured{}
+	buildConfig.SetGroupVersionKind(BuildConfigGVK)
+	buildConfig.SetName(buildName)
+	buildConfig.SetNamespace(owner.GetNamespace())
+
+	_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), buildConfig, func() error {
+		buildConfigSpec := map[string]interface{}{
+			"runPolicy": "Serial",
+			"source": map[string]interface{}{
+				"type":       "Binary",
+				"dockerfile": dockerfile,
+			},
+			"strategy": map[string]interface{}{
+				"type":           "Docker",
+				"dockerStrategy": map[string]interface{}{},
+			},
+			"output": map[string]interface{}{
+				"to": map[string]interface{}{
+					"kind": "ImageStreamTag",
+					"name": buildName + ":latest",
+				},
+			},
+			"successfulBuildsHistoryLimit": int64(2),
+			"failedBuildsHistoryLimit":     int64(2),
+		}
+
+		if err := uns.SetNestedMap(buildConfig.Object, buildConfigSpec, "spec"); err != nil {
+			return err
+		}
+
+		return controllerutil.SetControllerReference(owner, buildConfig, helper.GetScheme())
+	})
+
+	return err
+}
+
+// EnsureRAGBuildAccess creates the ServiceAccount, Role and RoleBinding named name that allow a Job to start
+// the binary build of the buildName BuildConfig.
+func EnsureRAGBuildAccess(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	owner client.Object,
+	name string,
+	buildName string,
+) error {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+		},
+	}
+	_, err := controllerutil.CreateOrPatch(ctx, helper.GetClient(), serviceAccount, func() error {
+		return controllerutil.SetControllerReference(owner, serviceAccount, helper.GetScheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), role, func() error {
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{"build.openshift.io"},
+				Resources:     []string{"buildconfigs/instantiatebinary"},
+				ResourceNames: []string{buildName},
+				Verbs:         []string{"create"},
+			},
+			{
+				APIGroups: []string{"build.openshift.io"},
+				Resources: []string{"builds"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"build.openshift.io"},
+				Resources: []string{"builds/log"},
+				Verbs:     []string{"get"},
+			},
+		}
+		return controllerutil.SetControllerReference(owner, role, helper.GetScheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccount.Name,
+				Namespace: owner.GetNamespace(),
+			},
+		}
+		return controllerutil.SetControllerReference(owner, roleBinding, helper.GetScheme())
+	})
+
+	return err
+}
+
+// CreateRAGStagingJob creates the Job that validates the content referenced in RAGSource and builds it into
+// the RAG image.
+func CreateRAGStagingJob(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	sourceHash string,
+) error {
+	ragSource := instance.Spec.RAGSource
+
+	env := []corev1.EnvVar{
+		{Name: "BUILD_CONFIG", Value: OpenShiftAILightspeedRAGBuildName},
+	}
+	volumes := []corev1.Volume{
+		{
+			Name:         "staging",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "staging", MountPath: "/staging"},
+	}
+
+	if ragSource.PVC != nil {
+		env = append(env, corev1.EnvVar{Name: "SOURCE_PATH", Value: ragSource.PVC.Path})
+		volumes = append(volumes, corev1.Volume{
+			Name: "source",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: ragSource.PVC.ClaimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name: "source", MountPath: "/source", ReadOnly: true,
+		})
+	} else {
+		env = append(env,
+			corev1.EnvVar{Name: "OCI_ARTIFACT", Value: ragSource.OCIArtifact.Reference},
+			corev1.EnvVar{Name: "SOURCE_PATH", Value: ragSource.OCIArtifact.Path},
+		)
+
+		// oc image extract accepts a single registry configuration
+		if pullSecrets := GetRAGImagePullSecrets(instance); len(pullSecrets) > 0 {
+			volumes = append(volumes, corev1.Volume{
+				Name: "pull-secret",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: pullSecrets[0].Name},
+				},
+			})
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name: "pull-secret", MountPath: ragStagingPullSecretPath, ReadOnly: true,
+			})
+		}
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedRAGStagingName,
+			Namespace: instance.Namespace,
+			Annotations: map[string]string{
+				OpenShiftAILightspeedRAGSourceHashAnnotation: sourceHash,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: OpenShiftAILightspeedRAGStagingName,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Volumes: volumes,
+					Containers: []corev1.Container{
+						{
+							Name:                     ragStagingContainerName,
+							Image:                    apiv1beta1.OpenShiftAILightspeedDefaultValues.CLIImageURL,
+							Command:                  []string{"/bin/sh", "-c", ragStagingScript},
+							Env:                      env,
+							VolumeMounts:             volumeMounts,
+							TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("50m"),
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("512Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: ptr.To(false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := controllerutil.SetControllerReference(instance, job, helper.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	helper.GetLogger().Info("Creating the RAG source staging job")
+	err = helper.GetClient().Create(ctx, job)
+	if err != nil && !k8s_errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetBuildOutputImage returns the image the given build pushed, pinned to its digest.
+func GetBuildOutputImage(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	namespace string,
+	buildName string,
+) (string, error) {
+	build := &uns.Unstructured{}
+	build.SetGroupVersionKind(BuildGVK)
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      buildName,
+		Namespace: namespace,
+	}, build)
+	if err != nil {
+		return "", err
+	}
+
+	imageReference, _, err := uns.NestedString(build.Object, "status", "outputDockerImageReference")
+	if err != nil {
+		return "", err
+	}
+
+	digest, _, err := uns.NestedString(build.Object, "status", "output", "to", "imageDigest")
+	if err != nil {
+		return "", err
+	}
+
+	if imageReference == "" || digest == "" {
+		return "", fmt.Errorf("build %s did not report the image it pushed", buildName)
+	}
+
+	return GetImageRepository(imageReference) + "@" + digest, nil
+}
+
+// GetRAGBuildJobResult returns the name of the build started by the given container of a Job running
+// ragBuildScript once the Job succeeded, or an empty string while it runs. Returns an error with the
+// termination message of the failed container if the Job failed.
+func GetRAGBuildJobResult(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	job *batchv1.Job,
+	containerName string,
+) (string, error) {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			message := GetJobFailureMessage(ctx, helper, job)
+			if message == "" {
+				message = c.Message
+			}
+			return "", errors.New(strings.TrimSpace(message))
+		}
+	}
+
+	if job.Status.Succeeded == 0 {
+		return "", nil
+	}
+
+	message := GetJobTerminationMessage(ctx, helper, job, containerName, corev1.PodSucceeded)
+	for _, line := range strings.Split(message, "\n") {
+		if buildName, found := strings.CutPrefix(strings.TrimSpace(line), "BUILD="); found && buildName != "" {
+			return buildName, nil
+		}
+	}
+
+	return "", fmt.Errorf("job %s succeeded but did not report the build it started", job.Name)
+}
+
+// GetJobTerminationMessage returns the termination message of the given container in a pod of the Job
+// that ended in the given phase, or an empty string when there is none.
+func GetJobTerminationMessage(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	job *batchv1.Job,
+	containerName string,
+	phase corev1.PodPhase,
+) string {
+	var pods corev1.PodList
+	err := helper.GetClient().List(ctx, &pods,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name},
+	)
+	if err != nil {
+		return ""
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != phase {
+			continue
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name == containerName && containerStatus.State.Terminated != nil {
+				return containerStatus.State.Terminated.Message
+			}
+		}
+	}
+
+	return ""
+}
+
+// GetJobFailureMessage returns the termination message of the first failed container, including the init
+// containers, in a failed pod of the Job, or an empty string when there is none.
+func GetJobFailureMessage(ctx context.Context, helper *common_helper.Helper, job *batchv1.Job) string {
+	var pods corev1.PodList
+	err := helper.GetClient().List(ctx, &pods,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name},
+	)
+	if err != nil {
+		return ""
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+
+		containerStatuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, containerStatus := range containerStatuses {
+			terminated := containerStatus.State.Terminated
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+
+			message := strings.TrimSpace(terminated.Message)
+			if message == "" {
+				message = terminated.Reason
+			}
+			return fmt.Sprintf("%s: %s", containerStatus.Name, message)
+		}
+	}
+
+	return ""
+}
+
+// RemoveRAGStagingJob deletes the RAG source staging Job together with its pods if it exists.
+func RemoveRAGStagingJob(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedRAGStagingName,
+			Namespace: instance.Namespace,
+		},
+	}
+
+	err := helper.GetClient().Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveRAGSource deletes the staging Job, its access and the BuildConfig and ImageStream of the staged RAG
+// image if they exist and are owned by the instance.
+func RemoveRAGSource(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	err := RemoveRAGStagingJob(ctx, helper, instance)
+	if err != nil {
+		return err
+	}
+
+	objects := []struct {
+		gvk  schema.GroupVersionKind
+		name string
+	}{
+		{BuildConfigGVK, OpenShiftAILightspeedRAGBuildName},
+		{ImageStreamGVK, OpenShiftAILightspeedRAGBuildName},
+		{rbacv1.SchemeGroupVersion.WithKind("RoleBinding"), OpenShiftAILightspeedRAGStagingName},
+		{rbacv1.SchemeGroupVersion.WithKind("Role"), OpenShiftAILightspeedRAGStagingName},
+		{corev1.SchemeGroupVersion.WithKind("ServiceAccount"), OpenShiftAILightspeedRAGStagingName},
+	}
+
+	for _, object := range objects {
+		_, err := RemoveInstanceOwnedObject(ctx, helper, instance, object.gvk, object.name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSpecHash returns a hash identifying the given spec. It is used to detect when content has to be staged
+// or indexed again.
+func GetSpecHash(spec interface{}) (string, error) {
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(specJSON)
+	return hex.EncodeToString(hash[:])[:16], nil
+}
+
+// ValidateRAGSource returns an error if the path of the content in ragSource is not absolute.
+func ValidateRAGSource(ragSource *apiv1beta1.RAGSourceSpec) error {
+	var sourcePath string
+	switch {
+	case ragSource.PVC != nil:
+		sourcePath = ragSource.PVC.Path
+	case ragSource.OCIArtifact != nil:
+		sourcePath = ragSource.OCIArtifact.Path
+	}
+
+	if sourcePath != "" && !path.IsAbs(sourcePath) {
+		return fmt.Errorf("ragSource path %q must be absolute", sourcePath)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+)
+
+var _ = Describe("RAG source staging", func() {
+	pvcSource := func(path string, revision string) *apiv1beta1.RAGSourceSpec {
+		return &apiv1beta1.RAGSourceSpec{
+			PVC:      &apiv1beta1.PVCRAGSource{ClaimName: "rag-content", Path: path},
+			Revision: revision,
+		}
+	}
+
+	It("should stage the content again when the source or its revision changes", func() {
+		hash, err := GetSpecHash(pvcSource("/", ""))
+		Expect(err).NotTo(HaveOccurred())
+
+		sameHash, err := GetSpecHash(pvcSource("/", ""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sameHash).To(Equal(hash))
+
+		pathHash, err := GetSpecHash(pvcSource("/vector_db", ""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pathHash).NotTo(Equal(hash))
+
+		revisionHash, err := GetSpecHash(pvcSource("/", "2"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revisionHash).NotTo(Equal(hash))
+	})
+
+	It("should require absolute paths", func() {
+		Expect(ValidateRAGSource(pvcSource("/vector_db", ""))).To(Succeed())
+		Expect(ValidateRAGSource(pvcSource("vector_db", ""))).NotTo(Succeed())
+		Expect(ValidateRAGSource(&apiv1beta1.RAGSourceSpec{
+			OCIArtifact: &apiv1beta1.OCIArtifactRAGSource{Reference: "quay.io/example/docs:v1", Path: "docs"},
+		})).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,367 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for checking the repository of the RAG image for newer tags.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// OpenShiftAILightspeedRAGUpdatesName - name of the ImageStreamImport listing the tags of the repository of
+	// the RAG image. It is not imported, so no ImageStream is created.
+	OpenShiftAILightspeedRAGUpdatesName = "openshift-ai-lightspeed-rag-updates"
+
+	// RAGImageUpdateCheckIntervalDefault - interval between the checks for newer RAG image tags when
+	// RAGImageUpdates.CheckInterval is not set
+	RAGImageUpdateCheckIntervalDefault = 24 * time.Hour
+
+	// RAGImageUpdateCheckIntervalMin - minimum interval between the checks for newer RAG image tags
+	RAGImageUpdateCheckIntervalMin = 10 * time.Minute
+
+	// RAGImageUpdateRetryInterval - interval after which a failed check for newer RAG image tags is retried
+	RAGImageUpdateRetryInterval = 5 * time.Minute
+)
+
+// ImageStreamImportGVK - GroupVersionKind of OpenShift ImageStreamImports
+var ImageStreamImportGVK = schema.GroupVersionKind{
+	Group:   "image.openshift.io",
+	Version: "v1",
+	Kind:    "ImageStreamImport",
+}
+
+// CheckRAGImageUpdates checks the repository of the RAG image for a newer tag once the check interval has
+// elapsed and records it in the instance status. With the AutoApply policy the newer tag is applied when
+// now is within the maintenance window. Returns the duration after which the updates have to be checked or
+// applied again.
+func CheckRAGImageUpdates(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	now time.Time,
+) (time.Duration, error) {
+	updates := instance.Spec.RAGImageUpdates
+
+	// The updates found for a previous RAG image do not apply anymore
+	if instance.Status.RAGImageUpdate == nil || instance.Status.RAGImageUpdate.BaseImage != instance.Spec.RAGImage {
+		instance.Status.RAGImageUpdate = &apiv1beta1.RAGImageUpdateStatus{BaseImage: instance.Spec.RAGImage}
+	}
+	status := instance.Status.RAGImageUpdate
+
+	interval := GetRAGImageUpdateCheckInterval(instance)
+	if status.LastCheckTime == nil || !now.Before(status.LastCheckTime.Add(interval)) {
+		// The default tag pattern matches the OpenShift AI version, which SelectRAGImage only detects when
+		// the RAG image is not set in the spec
+		if updates.TagPattern == "" {
+			rhoaiVersion, err := GetRHOAIVersion(ctx, helper, reader)
+			if err != nil {
+				return RAGImageUpdateRetryInterval, err
+			}
+			instance.Status.RHOAIVersion = rhoaiVersion
+		}
+
+		latestImage, err := GetLatestRAGImage(ctx, helper, instance, GetUpdatedRAGImage(instance))
+		if err != nil {
+			return RAGImageUpdateRetryInterval, err
+		}
+
+		if latestImage != "" && latestImage != status.LatestImage {
+			helper.GetLogger().Info("Newer RAG image available", "Image", latestImage)
+		}
+		status.LatestImage = latestImage
+		status.LastCheckTime = &metav1.Time{Time: now}
+	}
+	delay := status.LastCheckTime.Add(interval).Sub(now)
+
+	if status.LatestImage == "" || updates.Policy != apiv1beta1.RAGImageUpdatePolicyAutoApply {
+		return delay, nil
+	}
+
+	window := updates.MaintenanceWindow
+	isInWindow, nextStart, err := GetTimeWindow(window.Schedule, window.Duration.Duration, window.TimeZone, now)
+	if err != nil {
+		return delay, err
+	}
+
+	if isInWindow {
+		helper.GetLogger().Info("Applying the newer RAG image in the maintenance window",
+			"Image", status.LatestImage, "PreviousImage", GetUpdatedRAGImage(instance))
+		status.AppliedImage = status.LatestImage
+		status.LatestImage = ""
+		return delay, nil
+	}
+
+	return min(delay, nextStart.Sub(now)), nil
+}
+
+// GetUpdatedRAGImage returns the RAG image OLS is configured with. It is the newer RAG image applied in a
+// maintenance window, or the RAG image itself when no update has been applied.
+func GetUpdatedRAGImage(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	status := instance.Status.RAGImageUpdate
+	if instance.Spec.RAGImageUpdates != nil && status != nil && status.BaseImage == instance.Spec.RAGImage &&
+		status.AppliedImage != "" {
+		return status.AppliedImage
+	}
+
+	return instance.Spec.RAGImage
+}
+
+// GetRAGImageUpdateCheckInterval returns the interval between the checks for newer RAG image tags.
+func GetRAGImageUpdateCheckInterval(instance *apiv1beta1.OpenShiftAILightspeed) time.Duration {
+	if instance.Spec.RAGImageUpdates.CheckInterval == nil {
+		return RAGImageUpdateCheckIntervalDefault
+	}
+
+	return instance.Spec.RAGImageUpdates.CheckInterval.Duration
+}
+
+// GetLatestRAGImage returns the RAG image with the newest tag in the repository of image that matches the
+// tag pattern and is newer than the tag of image, or an empty string when there is none.
+func GetLatestRAGImage(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+	image string,
+) (string, error) {
+	tag, err := GetImageTag(image)
+	if err != nil {
+		return "", err
+	}
+
+	tagPattern, err := GetRAGImageTagPattern(instance)
+	if err != nil {
+		return "", err
+	}
+
+	repository := GetImageRepository(image)
+	tags, err := ListImageTags(ctx, helper, instance.Namespace, repository)
+	if err != nil {
+		return "", err
+	}
+
+	newerTag := SelectNewerImageTag(tags, tagPattern, tag)
+	if newerTag == "" {
+		return "", nil
+	}
+
+	return repository + ":" + newerTag, nil
+}
+
+// GetImageTag returns the tag of an image reference, latest when it has none. Returns an error for images
+// referenced by digest as they cannot be updated to a newer tag.
+func GetImageTag(image string) (string, error) {
+	if strings.Contains(image, "@") {
+		return "", fmt.Errorf("image %s is referenced by digest and has no tag", image)
+	}
+
+	// A colon after the last slash separates the tag, other colons separate the registry port
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:], nil
+	}
+
+	return "latest", nil
+}
+
+// GetRAGImageTagPattern returns the regular expression the newer RAG image tags must match. Defaults to the
+// tags containing the major.minor version of OpenShift AI installed in the cluster.
+func GetRAGImageTagPattern(instance *apiv1beta1.OpenShiftAILightspeed) (*regexp.Regexp, error) {
+	if instance.Spec.RAGImageUpdates.TagPattern != "" {
+		return regexp.Compile(instance.Spec.RAGImageUpdates.TagPattern)
+	}
+
+	major, minor, err := ParseMajorMinorVersion(instance.Status.RHOAIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("the OpenShift AI version series is not known, ragImageUpdates.tagPattern must be set")
+	}
+
+	return regexp.Compile(fmt.Sprintf(`(^|[^0-9.])v?%d\.%d($|[^0-9])`, major, minor))
+}
+
+// ListImageTags returns the tags of an image repository. The tags are listed by the image import of the
+// OpenShift API server with the pull secrets in namespace, without importing the images.
+func ListImageTags(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	namespace string,
+	repository string,
+) ([]string, error) {
+	imageStreamImport := &uns.Unstructured{}
+	imageStreamImport.SetGroupVersionKind(ImageStreamImportGVK)
+	imageStreamImport.SetName(OpenShiftAILightspeedRAGUpdatesName)
+	imageStreamImport.SetNamespace(namespace)
+	imageStreamImport.Object["spec"] = map[string]interface{}{
+		"import": false,
+		"repository": map[string]interface{}{
+			"from": map[string]interface{}{
+				"kind": "DockerImage",
+				"name": repository,
+			},
+		},
+	}
+
+	// ImageStreamImports are not stored, the result of the import is returned in the status
+	err := helper.GetClient().Create(ctx, imageStreamImport)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryStatus, found, err := uns.NestedMap(imageStreamImport.Object, "status", "repository")
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("the tags of %s were not listed", repository)
+	}
+
+	importStatus, _, _ := uns.NestedString(repositoryStatus, "status", "status")
+	if importStatus != metav1.StatusSuccess {
+		message, _, _ := uns.NestedString(repositoryStatus, "status", "message")
+		return nil, fmt.Errorf("the tags of %s could not be listed: %s", repository, message)
+	}
+
+	var tags []string
+	images, _, _ := uns.NestedSlice(repositoryStatus, "images")
+	for _, image := range images {
+		imageMap, ok := image.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if tag, _, _ := uns.NestedString(imageMap, "tag"); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	// Tags beyond the import limit of the cluster are only listed
+	additionalTags, _, _ := uns.NestedStringSlice(repositoryStatus, "additionalTags")
+	tags = append(tags, additionalTags...)
+
+	return tags, nil
+}
+
+// SelectNewerImageTag returns the newest of the tags matching tagPattern that is newer than currentTag, or an
+// empty string when there is none.
+func SelectNewerImageTag(tags []string, tagPattern *regexp.Regexp, currentTag string) string {
+	newestTag := currentTag
+	for _, tag := range tags {
+		if tagPattern.MatchString(tag) && CompareImageTags(tag, newestTag) > 0 {
+			newestTag = tag
+		}
+	}
+
+	if newestTag == currentTag {
+		return ""
+	}
+
+	return newestTag
+}
+
+// CompareImageTags compares two tags as versions. The numeric parts of the tags are compared as numbers and
+// the other parts as strings, so 2.22.10 is newer than 2.22.9. Returns -1, 0 or 1 like strings.Compare.
+func CompareImageTags(a string, b string) int {
+	partsA, partsB := splitImageTag(a), splitImageTag(b)
+
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numberA, errA := strconv.ParseUint(partsA[i], 10, 64)
+		numberB, errB := strconv.ParseUint(partsB[i], 10, 64)
+
+		if errA == nil && errB == nil {
+			if numberA != numberB {
+				if numberA < numberB {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+
+		if c := strings.Compare(partsA[i], partsB[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(partsA) < len(partsB):
+		return -1
+	case len(partsA) > len(partsB):
+		return 1
+	}
+
+	return 0
+}
+
+// splitImageTag splits a tag into its numeric and non-numeric parts.
+func splitImageTag(tag string) []string {
+	var parts []string
+
+	start := 0
+	for i := 1; i <= len(tag); i++ {
+		if i == len(tag) || isDigit(tag[i]) != isDigit(tag[start]) {
+			parts = append(parts, tag[start:i])
+			start = i
+		}
+	}
+
+	return parts
+}
+
+// isDigit returns true if c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// ValidateRAGImageUpdates returns an error if the tag pattern, the check interval or the maintenance window in
+// RAGImageUpdates is invalid.
+func ValidateRAGImageUpdates(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	updates := instance.Spec.RAGImageUpdates
+	if updates == nil {
+		return nil
+	}
+
+	if instance.Spec.RAGSource != nil {
+		return fmt.Errorf("ragImageUpdates cannot be combined with ragSource")
+	}
+
+	if _, err := regexp.Compile(updates.TagPattern); err != nil {
+		return fmt.Errorf("ragImageUpdates.tagPattern is invalid: %w", err)
+	}
+
+	if updates.CheckInterval != nil && updates.CheckInterval.Duration < RAGImageUpdateCheckIntervalMin {
+		return fmt.Errorf("ragImageUpdates.checkInterval must be at least %s", RAGImageUpdateCheckIntervalMin)
+	}
+
+	if updates.MaintenanceWindow != nil {
+		window := updates.MaintenanceWindow
+		if err := ValidateTimeWindow(window.Schedule, window.Duration.Duration, window.TimeZone); err != nil {
+			return fmt.Errorf("ragImageUpdates.maintenanceWindow is invalid: %w", err)
+		}
+	}
+
+	return nil
+}
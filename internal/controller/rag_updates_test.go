@@ -0,0 +1,148 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+)
+
+var _ = Describe("RAG image updates", func() {
+	It("should compare the numeric parts of the tags as numbers", func() {
+		Expect(CompareImageTags("2.22.10", "2.22.9")).To(Equal(1))
+		Expect(CompareImageTags("2.22", "2.22.1")).To(Equal(-1))
+		Expect(CompareImageTags("2.22.1", "2.22.1")).To(Equal(0))
+	})
+
+	It("should select the newest tag of the OpenShift AI version series", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				RAGImageUpdates: &apiv1beta1.RAGImageUpdatesSpec{},
+			},
+			Status: apiv1beta1.OpenShiftAILightspeedStatus{RHOAIVersion: "2.22.1"},
+		}
+
+		tagPattern, err := GetRAGImageTagPattern(instance)
+		Expect(err).NotTo(HaveOccurred())
+
+		tags := []string{"2.22.1", "2.22.9", "2.22.10", "2.23.0", "2.220", "latest"}
+		Expect(SelectNewerImageTag(tags, tagPattern, "2.22.1")).To(Equal("2.22.10"))
+		Expect(SelectNewerImageTag(tags, tagPattern, "2.22.10")).To(BeEmpty())
+	})
+
+	It("should require a tag pattern when the OpenShift AI version is not known", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				RAGImageUpdates: &apiv1beta1.RAGImageUpdatesSpec{},
+			},
+		}
+
+		_, err := GetRAGImageTagPattern(instance)
+		Expect(err).To(HaveOccurred())
+
+		instance.Spec.RAGImageUpdates.TagPattern = `^rhoai-docs-\d+\.\d+$`
+		tagPattern, err := GetRAGImageTagPattern(instance)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(SelectNewerImageTag([]string{"rhoai-docs-2025.2", "latest"}, tagPattern, "rhoai-docs-2025.1")).To(
+			Equal("rhoai-docs-2025.2"))
+	})
+
+	It("should return the tag of an image", func() {
+		Expect(GetImageTag("registry.example.com:5000/docs/rag:2.22")).To(Equal("2.22"))
+		Expect(GetImageTag("registry.example.com:5000/docs/rag")).To(Equal("latest"))
+
+		_, err := GetImageTag("quay.io/docs/rag@sha256:abc")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should use the applied image only for the RAG image it was found for", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				RAGImage:        "quay.io/docs/rag:2.22.1",
+				RAGImageUpdates: &apiv1beta1.RAGImageUpdatesSpec{},
+			},
+			Status: apiv1beta1.OpenShiftAILightspeedStatus{
+				RAGImageUpdate: &apiv1beta1.RAGImageUpdateStatus{
+					BaseImage:    "quay.io/docs/rag:2.22.1",
+					AppliedImage: "quay.io/docs/rag:2.22.2",
+				},
+			},
+		}
+		Expect(GetUpdatedRAGImage(instance)).To(Equal("quay.io/docs/rag:2.22.2"))
+
+		instance.Spec.RAGImage = "quay.io/docs/rag:2.23.0"
+		Expect(GetUpdatedRAGImage(instance)).To(Equal("quay.io/docs/rag:2.23.0"))
+	})
+
+	It("should detect the OpenShift AI version for a RAG image set in the spec", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			Spec: apiv1beta1.OpenShiftAILightspeedSpec{
+				RAGImage:        "quay.io/docs/rag:2.22.1",
+				RAGImageUpdates: &apiv1beta1.RAGImageUpdatesSpec{},
+			},
+		}
+		instance.Name = "openshift-ai-lightspeed"
+		instance.Namespace = "test-namespace"
+
+		dataScienceCluster := &uns.Unstructured{}
+		dataScienceCluster.SetGroupVersionKind(DataScienceClusterGVK)
+		dataScienceCluster.SetName("default-dsc")
+		Expect(uns.SetNestedField(dataScienceCluster.Object, "2.22.1", "status", "release", "version")).To(Succeed())
+
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1beta1.AddToScheme(scheme)).To(Succeed())
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(DataScienceClusterGVK, meta.RESTScopeRoot)
+
+		// The tags of the repository are returned in the status of the ImageStreamImport
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithRESTMapper(restMapper).
+			WithObjects(dataScienceCluster).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					return uns.SetNestedField(obj.(*uns.Unstructured).Object, map[string]interface{}{
+						"status":         map[string]interface{}{"status": "Success"},
+						"additionalTags": []interface{}{"2.22.1", "2.22.3", "2.23.0"},
+					}, "status", "repository")
+				},
+			}).
+			Build()
+		helper, err := common_helper.NewHelper(instance, fakeClient, nil, scheme, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = CheckRAGImageUpdates(context.Background(), helper, fakeClient, instance, time.Now())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instance.Status.RHOAIVersion).To(Equal("2.22.1"))
+		Expect(instance.Status.RAGImageUpdate.LatestImage).To(Equal("quay.io/docs/rag:2.22.3"))
+	})
+})
@@ -0,0 +1,207 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for selecting the RAG image matching the installed OpenShift AI version.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// RHOAIOperatorName - prefix of the name of the OpenShift AI operator CSV
+	RHOAIOperatorName = "rhods-operator"
+
+	// RHOAIOperatorNamespace - namespace the OpenShift AI operator is installed in
+	RHOAIOperatorNamespace = "redhat-ods-operator"
+
+	// OpenShiftAILightspeedRAGImagesConfigMapName - name of the ConfigMap in the namespace of the instance that
+	// maps OpenShift AI versions (major.minor) to RAG images. It takes precedence over the
+	// RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RAG_IMAGE_* environment variables.
+	OpenShiftAILightspeedRAGImagesConfigMapName = "openshift-ai-lightspeed-rag-images"
+)
+
+// DataScienceClusterGVK - GroupVersionKind of OpenShift AI DataScienceClusters
+var DataScienceClusterGVK = schema.GroupVersionKind{
+	Group:   "datasciencecluster.opendatahub.io",
+	Version: "v1",
+	Kind:    "DataScienceCluster",
+}
+
+// SelectRAGImage returns the RAG image with the documentation of the OpenShift AI version installed in the
+// cluster and records the detected and the selected version in the instance status. Falls back to the
+// environmental default RAG image when the version cannot be detected or no image matches it.
+func SelectRAGImage(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (string, error) {
+	instance.Status.RAGDocsVersion = ""
+
+	rhoaiVersion, err := GetRHOAIVersion(ctx, helper, reader)
+	if err != nil {
+		return "", err
+	}
+	instance.Status.RHOAIVersion = rhoaiVersion
+
+	ragImageURLs, err := GetRAGImageURLs(ctx, helper, instance)
+	if err != nil {
+		return "", err
+	}
+
+	docsVersion, found := SelectRAGImageVersion(ragImageURLs, rhoaiVersion)
+	if !found {
+		return apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGImageURL, nil
+	}
+
+	instance.Status.RAGDocsVersion = docsVersion
+	return ragImageURLs[docsVersion], nil
+}
+
+// GetRHOAIVersion returns the version of OpenShift AI installed in the cluster. The release reported by the
+// DataScienceCluster takes precedence over the version of the OpenShift AI operator CSV. Returns an empty
+// string when OpenShift AI is not installed.
+func GetRHOAIVersion(ctx context.Context, helper *common_helper.Helper, reader client.Reader) (string, error) {
+	// DataScienceClusters are cluster scoped and cached, see ClusterScopedWatchedGVKs
+	dataScienceClusters := &uns.UnstructuredList{}
+	dataScienceClusters.SetGroupVersionKind(DataScienceClusterGVK)
+	err := helper.GetClient().List(ctx, dataScienceClusters)
+	if err != nil && !k8s_errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return "", err
+	}
+
+	for _, dataScienceCluster := range dataScienceClusters.Items {
+		version, _, err := uns.NestedString(dataScienceCluster.Object, "status", "release", "version")
+		if err != nil {
+			return "", err
+		}
+		if version != "" {
+			return version, nil
+		}
+	}
+
+	// The OpenShift AI operator CSV lives outside of WATCH_NAMESPACE
+	var CSVs operatorsv1alpha1.ClusterServiceVersionList
+	err = reader.List(ctx, &CSVs, client.InNamespace(RHOAIOperatorNamespace), client.HasLabels{
+		fmt.Sprintf("operators.coreos.com/%s.%s", RHOAIOperatorName, RHOAIOperatorNamespace),
+	})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return "", err
+	}
+
+	for _, CSV := range CSVs.Items {
+		if strings.HasPrefix(CSV.GetName(), RHOAIOperatorName) &&
+			CSV.Status.Phase == operatorsv1alpha1.CSVPhaseSucceeded {
+			return CSV.Spec.Version.String(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// GetRAGImageURLs returns the OpenShift AI version to RAG image map. The entries of the
+// OpenShiftAILightspeedRAGImagesConfigMapName ConfigMap take precedence over the environmental ones.
+func GetRAGImageURLs(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (map[string]string, error) {
+	ragImageURLs := maps.Clone(apiv1beta1.OpenShiftAILightspeedDefaultValues.RAGImageURLs)
+	if ragImageURLs == nil {
+		ragImageURLs = map[string]string{}
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err := helper.GetClient().Get(ctx, client.ObjectKey{
+		Name:      OpenShiftAILightspeedRAGImagesConfigMapName,
+		Namespace: instance.Namespace,
+	}, configMap)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	for version, image := range configMap.Data {
+		if _, _, err := ParseMajorMinorVersion(version); err != nil {
+			return nil, fmt.Errorf("ConfigMap %s: %w", OpenShiftAILightspeedRAGImagesConfigMapName, err)
+		}
+		ragImageURLs[version] = image
+	}
+
+	return ragImageURLs, nil
+}
+
+// SelectRAGImageVersion returns the highest version in ragImageURLs that is not newer than rhoaiVersion.
+// Only the major and minor parts of the versions are compared. Returns false when no version matches.
+func SelectRAGImageVersion(ragImageURLs map[string]string, rhoaiVersion string) (string, bool) {
+	major, minor, err := ParseMajorMinorVersion(rhoaiVersion)
+	if err != nil {
+		return "", false
+	}
+
+	selectedVersion := ""
+	selectedMajor, selectedMinor := -1, -1
+	for version := range ragImageURLs {
+		imageMajor, imageMinor, err := ParseMajorMinorVersion(version)
+		if err != nil {
+			continue
+		}
+
+		if imageMajor > major || (imageMajor == major && imageMinor > minor) {
+			continue
+		}
+
+		if imageMajor > selectedMajor || (imageMajor == selectedMajor && imageMinor > selectedMinor) {
+			selectedVersion, selectedMajor, selectedMinor = version, imageMajor, imageMinor
+		}
+	}
+
+	return selectedVersion, selectedVersion != ""
+}
+
+// ParseMajorMinorVersion returns the major and the minor part of a version such as 2.22 or 2.22.1.
+func ParseMajorMinorVersion(version string) (int, int, error) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("version %q is not in the major.minor format", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("version %q is not in the major.minor format", version)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("version %q is not in the major.minor format", version)
+	}
+
+	return major, minor, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+)
+
+var _ = Describe("RAG image selection", func() {
+	ragImageURLs := map[string]string{
+		"2.19": "quay.io/example/rag:rhoai-docs-2.19",
+		"2.22": "quay.io/example/rag:rhoai-docs-2.22",
+		"3.0":  "quay.io/example/rag:rhoai-docs-3.0",
+	}
+
+	It("should select the image of the installed version", func() {
+		version, found := SelectRAGImageVersion(ragImageURLs, "2.22.1")
+		Expect(found).To(BeTrue())
+		Expect(version).To(Equal("2.22"))
+	})
+
+	It("should select the newest image that is not newer than the installed version", func() {
+		version, found := SelectRAGImageVersion(ragImageURLs, "2.21.0")
+		Expect(found).To(BeTrue())
+		Expect(version).To(Equal("2.19"))
+
+		version, found = SelectRAGImageVersion(ragImageURLs, "3.4.0")
+		Expect(found).To(BeTrue())
+		Expect(version).To(Equal("3.0"))
+	})
+
+	It("should not select any image for an older or unknown version", func() {
+		version, found := SelectRAGImageVersion(ragImageURLs, "2.16.0")
+		Expect(found).To(BeFalse())
+		Expect(version).To(BeEmpty())
+
+		version, found = SelectRAGImageVersion(ragImageURLs, "1.0.0")
+		Expect(found).To(BeFalse())
+		Expect(version).To(BeEmpty())
+
+		_, found = SelectRAGImageVersion(ragImageURLs, "")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should read the version to image map from the environment", func() {
+		Expect(apiv1beta1.GetRAGImageURLs([]string{
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_RAG_IMAGE_2_22=quay.io/example/rag:rhoai-docs-2.22",
+			"RELATED_IMAGE_OPENSHIFT_AI_LIGHTSPEED_IMAGE_URL_DEFAULT=quay.io/example/rag:default",
+		})).To(Equal(map[string]string{
+			"2.22": "quay.io/example/rag:rhoai-docs-2.22",
+		}))
+	})
+})
@@ -0,0 +1,278 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for evaluating the recurring time windows defined with cron schedules.
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TimeWindowMaxDuration - maximum length of a recurring time window
+	TimeWindowMaxDuration = 7 * 24 * time.Hour
+
+	// timeWindowSearchLimit - how far ahead the start of the next time window is searched for, long enough
+	// for schedules firing on February 29
+	timeWindowSearchLimit = 4 * 366 * 24 * time.Hour
+)
+
+// CronSchedule is a parsed cron expression with the minute, hour, day of month, month and day of week fields.
+// Each field is a bit set of the values it matches.
+type CronSchedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// A restricted day of month or day of week matches when either of them matches, as in cron
+	dayOfMonthRestricted bool
+	dayOfWeekRestricted  bool
+}
+
+// ParseCronSchedule parses a cron expression with 5 fields. Each field is *, a value, a range or a list of
+// them, optionally with a step (e.g. */15 or 1-5/2). Sunday is both 0 and 7 in the day of week field.
+func ParseCronSchedule(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q must have 5 fields", expression)
+	}
+
+	schedule := &CronSchedule{}
+	var err error
+	if schedule.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("minute of schedule %q: %w", expression, err)
+	}
+	if schedule.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("hour of schedule %q: %w", expression, err)
+	}
+	if schedule.dayOfMonth, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("day of month of schedule %q: %w", expression, err)
+	}
+	if schedule.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("month of schedule %q: %w", expression, err)
+	}
+	if schedule.dayOfWeek, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("day of week of schedule %q: %w", expression, err)
+	}
+
+	// Sunday is matched as 0
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+
+	schedule.dayOfMonthRestricted = !strings.HasPrefix(fields[2], "*")
+	schedule.dayOfWeekRestricted = !strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+// parseCronField returns the bit set of the values matched by a field of a cron expression.
+func parseCronField(field string, minValue int, maxValue int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		valueRange, stepValue, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepValue)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepValue)
+			}
+		}
+
+		start, end := minValue, maxValue
+		if valueRange != "*" {
+			startValue, endValue, isRange := strings.Cut(valueRange, "-")
+
+			var err error
+			start, err = strconv.Atoi(startValue)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", startValue)
+			}
+
+			end = start
+			if isRange {
+				end, err = strconv.Atoi(endValue)
+				if err != nil {
+					return 0, fmt.Errorf("invalid value %q", endValue)
+				}
+			} else if hasStep {
+				end = maxValue
+			}
+		}
+
+		if start < minValue || end > maxValue || start > end {
+			return 0, fmt.Errorf("%q is not within %d-%d", part, minValue, maxValue)
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << value
+		}
+	}
+
+	return bits, nil
+}
+
+// Matches returns true if the schedule fires at the minute of t.
+func (s *CronSchedule) Matches(t time.Time) bool {
+	if s.minute&(1<<t.Minute()) == 0 || s.hour&(1<<t.Hour()) == 0 || s.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+
+	return s.matchesDay(t)
+}
+
+// matchesDay returns true if the schedule fires on the day of t.
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<t.Day()) != 0
+	dayOfWeek := s.dayOfWeek&(1<<int(t.Weekday())) != 0
+
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+
+	return dayOfMonth && dayOfWeek
+}
+
+// Next returns the first time after t the schedule fires at, or false if it does not fire within
+// timeWindowSearchLimit.
+func (s *CronSchedule) Next(t time.Time) (time.Time, bool) {
+	limit := t.Add(timeWindowSearchLimit)
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		if s.month&(1<<int(t.Month())) == 0 || !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.hour&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// GetTimeWindow returns whether now is within a window starting at the times the cron schedule fires at in the
+// time zone and lasting for duration, and the start of the next window.
+func GetTimeWindow(
+	schedule string,
+	duration time.Duration,
+	timeZone string,
+	now time.Time,
+) (bool, time.Time, error) {
+	cronSchedule, err := ParseCronSchedule(schedule)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	location, err := GetTimeZone(timeZone)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	now = now.In(location)
+
+	_, isInWindow := getTimeWindowStart(cronSchedule, duration, now)
+
+	nextStart, found := cronSchedule.Next(now)
+	if !found {
+		return isInWindow, time.Time{}, fmt.Errorf("schedule %q does not start within 4 years", schedule)
+	}
+
+	return isInWindow, nextStart, nil
+}
+
+// GetTimeWindowEnd returns the end of the window now is within, like GetTimeWindow, or false when now is not
+// within a window. When windows overlap it is the end of the last one.
+func GetTimeWindowEnd(schedule string, duration time.Duration, timeZone string, now time.Time) (time.Time, bool, error) {
+	cronSchedule, err := ParseCronSchedule(schedule)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	location, err := GetTimeZone(timeZone)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	start, isInWindow := getTimeWindowStart(cronSchedule, duration, now.In(location))
+	if !isInWindow {
+		return time.Time{}, false, nil
+	}
+
+	return start.Add(duration), true, nil
+}
+
+// getTimeWindowStart returns the start of the last window lasting for duration now is within, or false when
+// now is not within a window.
+func getTimeWindowStart(cronSchedule *CronSchedule, duration time.Duration, now time.Time) (time.Time, bool) {
+	for start := now.Truncate(time.Minute); now.Sub(start) < duration; start = start.Add(-time.Minute) {
+		if cronSchedule.Matches(start) {
+			return start, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// GetTimeZone returns the location of an IANA time zone, UTC when it is empty.
+func GetTimeZone(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", timeZone)
+	}
+
+	return location, nil
+}
+
+// ValidateTimeWindow returns an error if the schedule, the duration or the time zone of a time window is invalid.
+func ValidateTimeWindow(schedule string, duration time.Duration, timeZone string) error {
+	cronSchedule, err := ParseCronSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	if _, found := cronSchedule.Next(time.Now()); !found {
+		return fmt.Errorf("schedule %q never starts", schedule)
+	}
+
+	if duration <= 0 || duration > TimeWindowMaxDuration {
+		return fmt.Errorf("duration %s must be positive and at most %s", duration, TimeWindowMaxDuration)
+	}
+
+	_, err = GetTimeZone(timeZone)
+	return err
+}
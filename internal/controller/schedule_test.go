@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Time windows", func() {
+	// Saturday
+	now := time.Date(2026, 10, 17, 2, 30, 0, 0, time.UTC)
+
+	It("should report the window now is in and the start of the next one", func() {
+		isInWindow, nextStart, err := GetTimeWindow("0 2 * * 6", 2*time.Hour, "", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isInWindow).To(BeTrue())
+		Expect(nextStart).To(BeTemporally("==", time.Date(2026, 10, 24, 2, 0, 0, 0, time.UTC)))
+
+		isInWindow, _, err = GetTimeWindow("0 2 * * 6", 2*time.Hour, "", now.Add(2*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isInWindow).To(BeFalse())
+	})
+
+	It("should evaluate the schedule in the time zone", func() {
+		// 2:30 UTC is 4:30 in Prague
+		isInWindow, _, err := GetTimeWindow("0 4 * * *", time.Hour, "Europe/Prague", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isInWindow).To(BeTrue())
+	})
+
+	It("should match either the day of month or the day of week when both are restricted", func() {
+		_, nextStart, err := GetTimeWindow("0 9 1,15 * 1", time.Hour, "", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nextStart).To(BeTemporally("==", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)))
+	})
+
+	It("should reject invalid schedules", func() {
+		Expect(ValidateTimeWindow("0 2 * * 6", time.Hour, "")).To(Succeed())
+		Expect(ValidateTimeWindow("*/15 9-17 * * 1-5", time.Hour, "America/New_York")).To(Succeed())
+		Expect(ValidateTimeWindow("0 2 * *", time.Hour, "")).NotTo(Succeed())
+		Expect(ValidateTimeWindow("60 2 * * *", time.Hour, "")).NotTo(Succeed())
+		Expect(ValidateTimeWindow("0 0 31 2 *", time.Hour, "")).NotTo(Succeed())
+		Expect(ValidateTimeWindow("0 2 * * 6", 8*24*time.Hour, "")).NotTo(Succeed())
+		Expect(ValidateTimeWindow("0 2 * * 6", time.Hour, "Mars/Olympus_Mons")).NotTo(Succeed())
+	})
+})
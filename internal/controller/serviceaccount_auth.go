@@ -0,0 +1,307 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for authenticating OLS against KServe InferenceServices
+// with a token of a ServiceAccount managed by the operator.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// OpenShiftAILightspeedServiceAccountName - name of the ServiceAccount whose token is used by OLS to
+	// access the InferenceService
+	OpenShiftAILightspeedServiceAccountName = "openshift-ai-lightspeed-llm"
+
+	// OpenShiftAILightspeedTokenSecretName - name of the secret that holds the ServiceAccount token in the
+	// format expected by OLS
+	OpenShiftAILightspeedTokenSecretName = "openshift-ai-lightspeed-llm-token"
+
+	// OpenShiftAILightspeedTokenExpirationAnnotation - annotation on the token secret that holds the
+	// expiration time of the token in RFC3339 format
+	OpenShiftAILightspeedTokenExpirationAnnotation = "openshift-ai.io/token-expiration"
+
+	// LLMCredentialsSecretKey - key in the credentials secret that OLS reads the API token from
+	LLMCredentialsSecretKey = "apitoken"
+
+	// tokenRotationFraction - fraction of the token lifetime that has to be left before the token is rotated
+	tokenRotationFraction = 5
+)
+
+// EnsureServiceAccountToken makes sure that the ServiceAccount used by OLS to access the InferenceService
+// returned by GetServiceAccountAuthInferenceService exists, is allowed to access the InferenceService and that its token
+// is stored in the OpenShiftAILightspeedTokenSecretName secret. The token is rotated once less than
+// 1/tokenRotationFraction of its lifetime is left. Returns the duration after which the token should be
+// checked again.
+func EnsureServiceAccountToken(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (time.Duration, error) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedServiceAccountName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrPatch(ctx, helper.GetClient(), serviceAccount, func() error {
+		return controllerutil.SetControllerReference(instance, serviceAccount, helper.GetScheme())
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = EnsureInferenceServiceAccess(ctx, helper, reader, instance)
+	if err != nil {
+		return 0, err
+	}
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedTokenSecretName,
+			Namespace: instance.Namespace,
+		},
+	}
+	err = helper.GetClient().Get(ctx, client.ObjectKeyFromObject(tokenSecret), tokenSecret)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return 0, err
+	}
+
+	var expirationSeconds int64
+	if instance.Spec.LLMServiceAccountAuth != nil {
+		expirationSeconds = instance.Spec.LLMServiceAccountAuth.TokenExpirationSeconds
+	}
+	if expirationSeconds == 0 {
+		expirationSeconds = apiv1beta1.TokenExpirationSecondsDefault
+	}
+	lifetime := time.Duration(expirationSeconds) * time.Second
+
+	rotateIn := GetTokenRotationDelay(tokenSecret, lifetime, time.Now())
+	if rotateIn > 0 {
+		return rotateIn, nil
+	}
+
+	helper.GetLogger().Info("Requesting a new ServiceAccount token", "ServiceAccount", serviceAccount.Name)
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.To(expirationSeconds),
+		},
+	}
+	err = helper.GetClient().SubResource("token").Create(ctx, serviceAccount, tokenRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	tokenSecret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OpenShiftAILightspeedTokenSecretName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrPatch(ctx, helper.GetClient(), tokenSecret, func() error {
+		annotations := tokenSecret.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[OpenShiftAILightspeedTokenExpirationAnnotation] =
+			tokenRequest.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)
+		tokenSecret.SetAnnotations(annotations)
+
+		tokenSecret.Type = corev1.SecretTypeOpaque
+		tokenSecret.Data = map[string][]byte{
+			LLMCredentialsSecretKey: []byte(tokenRequest.Status.Token),
+		}
+
+		return controllerutil.SetControllerReference(instance, tokenSecret, helper.GetScheme())
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return GetTokenRotationDelay(tokenSecret, lifetime, time.Now()), nil
+}
+
+// GetTokenRotationDelay returns the duration after which the token stored in tokenSecret has to be
+// rotated. A token is rotated once less than 1/tokenRotationFraction of its lifetime is left. Returns 0
+// when the token has to be rotated right away (e.g. the secret does not exist yet or it is malformed).
+func GetTokenRotationDelay(tokenSecret *corev1.Secret, lifetime time.Duration, now time.Time) time.Duration {
+	if len(tokenSecret.Data[LLMCredentialsSecretKey]) == 0 {
+		return 0
+	}
+
+	expiration, err := time.Parse(
+		time.RFC3339, tokenSecret.GetAnnotations()[OpenShiftAILightspeedTokenExpirationAnnotation])
+	if err != nil {
+		return 0
+	}
+
+	rotateAt := expiration.Add(-lifetime / tokenRotationFraction)
+	if !now.Before(rotateAt) {
+		return 0
+	}
+
+	return rotateAt.Sub(now)
+}
+
+// GetServiceAccountAuthInferenceService returns the InferenceService the operator managed ServiceAccount
+// is granted access to. It defaults to the InferenceService referenced in InferenceServiceRef. The
+// InferenceService of the managed model is always accessed with the ServiceAccount token. Returns nil
+// when no InferenceService is accessed with the ServiceAccount token.
+func GetServiceAccountAuthInferenceService(
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) *apiv1beta1.InferenceServiceReference {
+	if instance.Spec.ManagedModel != nil {
+		return GetManagedModelInferenceServiceRef(instance)
+	}
+
+	if instance.Spec.LLMServiceAccountAuth == nil {
+		return nil
+	}
+
+	if instance.Spec.LLMServiceAccountAuth.InferenceService != nil {
+		return instance.Spec.LLMServiceAccountAuth.InferenceService
+	}
+
+	return instance.Spec.InferenceServiceRef
+}
+
+// EnsureInferenceServiceAccess creates a Role and a RoleBinding in the namespace of the InferenceService
+// returned by GetServiceAccountAuthInferenceService that allow the operator managed ServiceAccount to query it. The
+// Role and the RoleBinding created in another namespace for a previous InferenceService are removed.
+func EnsureInferenceServiceAccess(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	inferenceService := GetServiceAccountAuthInferenceService(instance)
+	namespace := GetInferenceServiceNamespace(instance, inferenceService)
+
+	if instance.Status.InferenceServiceAccessNamespace != namespace {
+		err := RemoveInferenceServiceAccess(ctx, helper, instance)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The InferenceService may live outside of WATCH_NAMESPACE. The namespace is recorded first, so that a
+	// partially created access is removed too.
+	uncachedClient := GetUncachedClient(helper, reader)
+	instance.Status.InferenceServiceAccessNamespace = namespace
+
+	ownerLabels := map[string]string{
+		OpenShiftAILightspeedOwnerIDLabel: string(instance.GetUID()),
+	}
+
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetInferenceServiceAccessName(instance),
+			Namespace: namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, uncachedClient, role, func() error {
+		role.SetLabels(ownerLabels)
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{"serving.kserve.io"},
+				Resources:     []string{"inferenceservices"},
+				ResourceNames: []string{inferenceService.Name},
+				Verbs:         []string{"get"},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetInferenceServiceAccessName(instance),
+			Namespace: namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, uncachedClient, roleBinding, func() error {
+		roleBinding.SetLabels(ownerLabels)
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      OpenShiftAILightspeedServiceAccountName,
+				Namespace: instance.Namespace,
+			},
+		}
+		return nil
+	})
+
+	return err
+}
+
+// RemoveInferenceServiceAccess deletes the Role and the RoleBinding created by EnsureInferenceServiceAccess in the
+// namespace recorded in the instance status. Nothing is done when no access was created.
+func RemoveInferenceServiceAccess(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	namespace := instance.Status.InferenceServiceAccessNamespace
+	if namespace == "" {
+		return nil
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      GetInferenceServiceAccessName(instance),
+		Namespace: namespace,
+	}
+	for _, object := range []client.Object{
+		&rbacv1.RoleBinding{ObjectMeta: objectMeta},
+		&rbacv1.Role{ObjectMeta: objectMeta},
+	} {
+		err := helper.GetClient().Delete(ctx, object)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	instance.Status.InferenceServiceAccessNamespace = ""
+	return nil
+}
+
+// GetInferenceServiceAccessName returns the name of the Role and RoleBinding that grant the operator managed
+// ServiceAccount access to the InferenceService. The first 5 characters of the instance's UID are appended
+// to avoid collisions between multiple instances referencing InferenceServices in the same namespace.
+func GetInferenceServiceAccessName(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	return fmt.Sprintf("%s-%s", OpenShiftAILightspeedServiceAccountName, string(instance.GetUID())[:5])
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ServiceAccount token rotation", func() {
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	lifetime := time.Hour
+
+	tokenSecret := func(expiration string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					OpenShiftAILightspeedTokenExpirationAnnotation: expiration,
+				},
+			},
+			Data: map[string][]byte{
+				LLMCredentialsSecretKey: []byte("token"),
+			},
+		}
+	}
+
+	It("should rotate a missing token right away", func() {
+		Expect(GetTokenRotationDelay(&corev1.Secret{}, lifetime, now)).To(BeZero())
+	})
+
+	It("should rotate a token with a malformed expiration right away", func() {
+		Expect(GetTokenRotationDelay(tokenSecret("tomorrow"), lifetime, now)).To(BeZero())
+	})
+
+	It("should rotate a token once less than a fifth of its lifetime is left", func() {
+		expiration := now.Add(10 * time.Minute).Format(time.RFC3339)
+		Expect(GetTokenRotationDelay(tokenSecret(expiration), lifetime, now)).To(BeZero())
+	})
+
+	It("should wait until a fifth of the token lifetime is left", func() {
+		expiration := now.Add(lifetime).Format(time.RFC3339)
+		Expect(GetTokenRotationDelay(tokenSecret(expiration), lifetime, now)).To(Equal(48 * time.Minute))
+	})
+})
@@ -0,0 +1,297 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for applying the TLS security profile of the cluster to the servers of the
+// operator.
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// ClusterAPIServerName - name of the cluster-wide APIServer config holding the TLS security profile
+	ClusterAPIServerName = "cluster"
+
+	// TLSProfileOldType - profile for services that need to be accessed by very old clients
+	TLSProfileOldType = "Old"
+
+	// TLSProfileIntermediateType - profile recommended for most services, the default of the cluster
+	TLSProfileIntermediateType = "Intermediate"
+
+	// TLSProfileModernType - profile for services that are only accessed by clients supporting TLS 1.3
+	TLSProfileModernType = "Modern"
+
+	// TLSProfileCustomType - profile with the ciphers and minimum TLS version set by the administrator
+	TLSProfileCustomType = "Custom"
+)
+
+// APIServerGVK - GroupVersionKind of the OpenShift cluster-wide APIServer config
+var APIServerGVK = schema.GroupVersionKind{
+	Group:   "config.openshift.io",
+	Version: "v1",
+	Kind:    "APIServer",
+}
+
+// TLSProfileSpec contains the ciphers, in the OpenSSL or IANA notation, and the minimum TLS version of a TLS
+// security profile
+type TLSProfileSpec struct {
+	Ciphers       []string
+	MinTLSVersion string
+}
+
+// TLSProfiles contains the ciphers and minimum TLS version of the predefined TLS security profiles of OpenShift
+var TLSProfiles = map[string]TLSProfileSpec{
+	TLSProfileOldType: {
+		Ciphers: []string{
+			"TLS_AES_128_GCM_SHA256",
+			"TLS_AES_256_GCM_SHA384",
+			"TLS_CHACHA20_POLY1305_SHA256",
+			"ECDHE-ECDSA-AES128-GCM-SHA256",
+			"ECDHE-RSA-AES128-GCM-SHA256",
+			"ECDHE-ECDSA-AES256-GCM-SHA384",
+			"ECDHE-RSA-AES256-GCM-SHA384",
+			"ECDHE-ECDSA-CHACHA20-POLY1305",
+			"ECDHE-RSA-CHACHA20-POLY1305",
+			"DHE-RSA-AES128-GCM-SHA256",
+			"DHE-RSA-AES256-GCM-SHA384",
+			"DHE-RSA-CHACHA20-POLY1305",
+			"ECDHE-ECDSA-AES128-SHA256",
+			"ECDHE-RSA-AES128-SHA256",
+			"ECDHE-ECDSA-AES128-SHA",
+			"ECDHE-RSA-AES128-SHA",
+			"ECDHE-ECDSA-AES256-SHA384",
+			"ECDHE-RSA-AES256-SHA384",
+			"ECDHE-ECDSA-AES256-SHA",
+			"ECDHE-RSA-AES256-SHA",
+			"DHE-RSA-AES128-SHA256",
+			"DHE-RSA-AES256-SHA256",
+			"AES128-GCM-SHA256",
+			"AES256-GCM-SHA384",
+			"AES128-SHA256",
+			"AES256-SHA256",
+			"AES128-SHA",
+			"AES256-SHA",
+			"DES-CBC3-SHA",
+		},
+		MinTLSVersion: "VersionTLS10",
+	},
+	TLSProfileIntermediateType: {
+		Ciphers: []string{
+			"TLS_AES_128_GCM_SHA256",
+			"TLS_AES_256_GCM_SHA384",
+			"TLS_CHACHA20_POLY1305_SHA256",
+			"ECDHE-ECDSA-AES128-GCM-SHA256",
+			"ECDHE-RSA-AES128-GCM-SHA256",
+			"ECDHE-ECDSA-AES256-GCM-SHA384",
+			"ECDHE-RSA-AES256-GCM-SHA384",
+			"ECDHE-ECDSA-CHACHA20-POLY1305",
+			"ECDHE-RSA-CHACHA20-POLY1305",
+			"DHE-RSA-AES128-GCM-SHA256",
+			"DHE-RSA-AES256-GCM-SHA384",
+		},
+		MinTLSVersion: "VersionTLS12",
+	},
+	TLSProfileModernType: {
+		Ciphers: []string{
+			"TLS_AES_128_GCM_SHA256",
+			"TLS_AES_256_GCM_SHA384",
+			"TLS_CHACHA20_POLY1305_SHA256",
+		},
+		MinTLSVersion: "VersionTLS13",
+	},
+}
+
+// tlsVersions maps the TLS versions of the TLS security profiles to the crypto/tls ones
+var tlsVersions = map[string]uint16{
+	"VersionTLS10": tls.VersionTLS10,
+	"VersionTLS11": tls.VersionTLS11,
+	"VersionTLS12": tls.VersionTLS12,
+	"VersionTLS13": tls.VersionTLS13,
+}
+
+// openSSLCiphers maps the OpenSSL names of the ciphers supported by crypto/tls to their IANA names
+var openSSLCiphers = map[string]string{
+	"ECDHE-ECDSA-AES128-GCM-SHA256": "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"ECDHE-RSA-AES128-GCM-SHA256":   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"ECDHE-ECDSA-AES256-GCM-SHA384": "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	"ECDHE-RSA-AES256-GCM-SHA384":   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"ECDHE-ECDSA-CHACHA20-POLY1305": "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+	"ECDHE-RSA-CHACHA20-POLY1305":   "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	"ECDHE-ECDSA-AES128-SHA256":     "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	"ECDHE-RSA-AES128-SHA256":       "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	"ECDHE-ECDSA-AES128-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	"ECDHE-RSA-AES128-SHA":          "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	"ECDHE-ECDSA-AES256-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	"ECDHE-RSA-AES256-SHA":          "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	"AES128-GCM-SHA256":             "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	"AES256-GCM-SHA384":             "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	"AES128-SHA256":                 "TLS_RSA_WITH_AES_128_CBC_SHA256",
+	"AES128-SHA":                    "TLS_RSA_WITH_AES_128_CBC_SHA",
+	"AES256-SHA":                    "TLS_RSA_WITH_AES_256_CBC_SHA",
+	"DES-CBC3-SHA":                  "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+}
+
+// GetClusterTLSProfile returns the TLS security profile of the cluster-wide APIServer config. The
+// Intermediate profile, the default of OpenShift, is returned when none is set or the APIServer config is not
+// available.
+func GetClusterTLSProfile(ctx context.Context, reader client.Reader) (TLSProfileSpec, error) {
+	apiServer := &uns.Unstructured{}
+	apiServer.SetGroupVersionKind(APIServerGVK)
+	err := reader.Get(ctx, client.ObjectKey{Name: ClusterAPIServerName}, apiServer)
+	if err != nil && (k8s_errors.IsNotFound(err) || meta.IsNoMatchError(err)) {
+		return TLSProfiles[TLSProfileIntermediateType], nil
+	} else if err != nil {
+		return TLSProfileSpec{}, err
+	}
+
+	tlsSecurityProfile, _, _ := uns.NestedMap(apiServer.Object, "spec", "tlsSecurityProfile")
+	return GetTLSProfileSpec(tlsSecurityProfile)
+}
+
+// GetTLSProfileSpec returns the ciphers and minimum TLS version of the given spec.tlsSecurityProfile of the
+// APIServer config.
+func GetTLSProfileSpec(tlsSecurityProfile map[string]interface{}) (TLSProfileSpec, error) {
+	profileType, _, _ := uns.NestedString(tlsSecurityProfile, "type")
+	switch profileType {
+	case "":
+		return TLSProfiles[TLSProfileIntermediateType], nil
+	case TLSProfileOldType, TLSProfileIntermediateType, TLSProfileModernType:
+		return TLSProfiles[profileType], nil
+	case TLSProfileCustomType:
+		ciphers, _, _ := uns.NestedStringSlice(tlsSecurityProfile, "custom", "ciphers")
+		minTLSVersion, _, _ := uns.NestedString(tlsSecurityProfile, "custom", "minTLSVersion")
+		return TLSProfileSpec{Ciphers: ciphers, MinTLSVersion: minTLSVersion}, nil
+	default:
+		return TLSProfileSpec{}, fmt.Errorf("unknown TLS security profile type %q", profileType)
+	}
+}
+
+// GetTLSConfigFunc returns the function applying the minimum TLS version and the ciphers of the profile to a
+// TLS config, for the TLSOpts of the metrics and webhook servers. The TLS 1.3 ciphers are not configurable
+// in crypto/tls and the ciphers it doesn't support are skipped; the latter are returned.
+func GetTLSConfigFunc(profile TLSProfileSpec) (func(*tls.Config), []string, error) {
+	minVersion, ok := tlsVersions[profile.MinTLSVersion]
+	if profile.MinTLSVersion == "" {
+		minVersion = tls.VersionTLS12
+	} else if !ok {
+		return nil, nil, fmt.Errorf("unknown minimum TLS version %q", profile.MinTLSVersion)
+	}
+
+	cipherSuites := map[string]*tls.CipherSuite{}
+	for _, cipherSuite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		cipherSuites[cipherSuite.Name] = cipherSuite
+	}
+
+	var cipherSuiteIDs []uint16
+	var unsupported []string
+	for _, cipher := range profile.Ciphers {
+		name := cipher
+		if ianaName, ok := openSSLCiphers[cipher]; ok {
+			name = ianaName
+		}
+
+		cipherSuite, ok := cipherSuites[name]
+		if !ok {
+			unsupported = append(unsupported, cipher)
+			continue
+		}
+		if slices.Equal(cipherSuite.SupportedVersions, []uint16{tls.VersionTLS13}) {
+			continue
+		}
+		cipherSuiteIDs = append(cipherSuiteIDs, cipherSuite.ID)
+	}
+
+	return func(c *tls.Config) {
+		c.MinVersion = minVersion
+		c.CipherSuites = cipherSuiteIDs
+	}, unsupported, nil
+}
+
+// ErrTLSProfileChanged - cause of the restart of the operator when the TLS security profile of the cluster
+// changed
+var ErrTLSProfileChanged = errors.New("TLS security profile of the cluster changed")
+
+// TLSProfileReconciler watches the TLS security profile of the cluster and calls OnChange when it differs from
+// Profile, the one the servers of the operator have been started with, so they are restarted with it.
+type TLSProfileReconciler struct {
+	client.Client
+	Profile  TLSProfileSpec
+	OnChange func()
+}
+
+// GetLogger returns a logger object with a prefix of "controller.name" and additional controller context fields
+func (r *TLSProfileReconciler) GetLogger(ctx context.Context) logr.Logger {
+	return log.FromContext(ctx).WithName("Controllers").WithName("TLSProfile")
+}
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=apiservers,verbs=get;list;watch
+
+// Reconcile compares the TLS security profile of the cluster with the one the servers have been started with.
+func (r *TLSProfileReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	Log := r.GetLogger(ctx)
+
+	profile, err := GetClusterTLSProfile(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !IsTLSProfileChanged(r.Profile, profile) {
+		return ctrl.Result{}, nil
+	}
+
+	Log.Info("TLS security profile of the cluster changed, restarting the metrics and webhook servers",
+		"minTLSVersion", profile.MinTLSVersion)
+	r.OnChange()
+	return ctrl.Result{}, nil
+}
+
+// IsTLSProfileChanged returns true if the minimum TLS version or the ciphers of the profiles differ.
+func IsTLSProfileChanged(current TLSProfileSpec, profile TLSProfileSpec) bool {
+	return current.MinTLSVersion != profile.MinTLSVersion || !slices.Equal(current.Ciphers, profile.Ciphers)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TLSProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	apiServer := &uns.Unstructured{}
+	apiServer.SetGroupVersionKind(APIServerGVK)
+
+	// The servers of every replica, not only the leader, have to be restarted with the new profile
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("tlsprofile").
+		For(apiServer, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetName() == ClusterAPIServerName
+		}))).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Complete(r)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLS security profile", func() {
+	It("should default to the Intermediate profile", func() {
+		profile, err := GetTLSProfileSpec(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile).To(Equal(TLSProfiles[TLSProfileIntermediateType]))
+
+		profile, err = GetTLSProfileSpec(map[string]interface{}{"type": "Modern", "modern": map[string]interface{}{}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile).To(Equal(TLSProfiles[TLSProfileModernType]))
+
+		_, err = GetTLSProfileSpec(map[string]interface{}{"type": "Unknown"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should read a Custom profile", func() {
+		profile, err := GetTLSProfileSpec(map[string]interface{}{
+			"type": "Custom",
+			"custom": map[string]interface{}{
+				"ciphers":       []interface{}{"ECDHE-RSA-AES128-GCM-SHA256"},
+				"minTLSVersion": "VersionTLS12",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile).To(Equal(TLSProfileSpec{
+			Ciphers:       []string{"ECDHE-RSA-AES128-GCM-SHA256"},
+			MinTLSVersion: "VersionTLS12",
+		}))
+	})
+
+	It("should apply the minimum TLS version and the ciphers", func() {
+		tlsProfileOpt, unsupported, err := GetTLSConfigFunc(TLSProfiles[TLSProfileIntermediateType])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unsupported).To(ConsistOf("DHE-RSA-AES128-GCM-SHA256", "DHE-RSA-AES256-GCM-SHA384"))
+
+		config := &tls.Config{}
+		tlsProfileOpt(config)
+		Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+		Expect(config.CipherSuites).To(Equal([]uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+		}))
+
+		tlsProfileOpt, unsupported, err = GetTLSConfigFunc(TLSProfiles[TLSProfileModernType])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unsupported).To(BeEmpty())
+		tlsProfileOpt(config)
+		Expect(config.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+		Expect(config.CipherSuites).To(BeEmpty())
+
+		_, _, err = GetTLSConfigFunc(TLSProfileSpec{MinTLSVersion: "VersionTLS14"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should detect the changes of the profile", func() {
+		intermediate := TLSProfiles[TLSProfileIntermediateType]
+		Expect(IsTLSProfileChanged(intermediate, TLSProfiles[TLSProfileIntermediateType])).To(BeFalse())
+		Expect(IsTLSProfileChanged(intermediate, TLSProfiles[TLSProfileModernType])).To(BeTrue())
+	})
+})
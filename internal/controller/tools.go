@@ -0,0 +1,419 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the logic for the tools OLS lets the LLM call, including the read-only RHOAI MCP server
+// deployed by the operator.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/blang/semver/v4"
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	common_helper "github.com/opendatahub-io/openshift-ai-lightspeed-operator/pkg/common/helper"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// OLSToolCallingMinVersion - minimum version of the OLS operator supporting the introspection and the MCP
+	// servers
+	OLSToolCallingMinVersion = "1.0.5"
+
+	// RHOAIMCPServerName - name of the Deployment, the Service and the ServiceAccount of the RHOAI MCP server,
+	// and of the MCP server in the OLSConfig
+	RHOAIMCPServerName = "openshift-ai-lightspeed-rhoai-mcp"
+
+	// mcpServerFeatureGate - OLSConfig feature gate enabling the MCP servers
+	mcpServerFeatureGate = "MCPServer"
+
+	// rhoaiMCPServerPort - port the RHOAI MCP server listens on
+	rhoaiMCPServerPort = 8080
+)
+
+// RHOAIMCPServerRules - read-only access of the RHOAI MCP server to the OpenShift AI resources
+var RHOAIMCPServerRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"kubeflow.org"},
+		Resources: []string{"notebooks"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"datasciencepipelinesapplications.opendatahub.io"},
+		Resources: []string{"datasciencepipelinesapplications"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"serving.kserve.io"},
+		Resources: []string{"inferenceservices", "servingruntimes"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"datasciencecluster.opendatahub.io"},
+		Resources: []string{"datascienceclusters"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+}
+
+// GetToolCallingUnsupportedReason returns why the installed OLS operator does not support tool calling, or an
+// empty string when it does.
+func GetToolCallingUnsupportedReason(ctx context.Context, helper *common_helper.Helper) (string, error) {
+	OLSOperatorCSV, err := GetOLSOperatorCSV(ctx, helper)
+	if err != nil {
+		return "", err
+	} else if OLSOperatorCSV == nil {
+		return "the OpenShift Lightspeed operator is not installed", nil
+	}
+
+	if !IsToolCallingSupported(OLSOperatorCSV.Spec.Version.Version) {
+		return fmt.Sprintf("the OpenShift Lightspeed operator %s does not support it, %s or newer is required",
+			OLSOperatorCSV.Spec.Version.String(), OLSToolCallingMinVersion), nil
+	}
+
+	return "", nil
+}
+
+// IsToolCallingSupported returns true if the given version of the OLS operator supports tool calling.
+// Pre-releases of the minimum version are accepted.
+func IsToolCallingSupported(version semver.Version) bool {
+	minVersion := semver.MustParse(OLSToolCallingMinVersion)
+	version.Pre = nil
+	version.Build = nil
+
+	return version.GTE(minVersion)
+}
+
+// ValidateTools returns an error if an MCP server uses the name of the RHOAI MCP server deployed by the operator.
+func ValidateTools(instance *apiv1beta1.OpenShiftAILightspeed) error {
+	if instance.Spec.Tools == nil {
+		return nil
+	}
+
+	for _, mcpServer := range instance.Spec.Tools.MCPServers {
+		if mcpServer.Name == RHOAIMCPServerName {
+			return fmt.Errorf("MCP server name %s is reserved for the RHOAI MCP server", RHOAIMCPServerName)
+		}
+	}
+
+	return nil
+}
+
+// GetRHOAIMCPServer returns the settings of the RHOAI MCP server, or nil when it is not deployed.
+func GetRHOAIMCPServer(instance *apiv1beta1.OpenShiftAILightspeed) *apiv1beta1.RHOAIMCPServerSpec {
+	if instance.Spec.Tools == nil {
+		return nil
+	}
+
+	return instance.Spec.Tools.RHOAIMCPServer
+}
+
+// EnsureRHOAIMCPServer creates or updates the ServiceAccount, the Deployment and the Service of the RHOAI MCP
+// server, along with a ClusterRole and a ClusterRoleBinding granting the ServiceAccount read-only access to
+// the OpenShift AI resources. Returns whether the MCP server is ready, and a message describing what it is
+// waiting for when it is not.
+func EnsureRHOAIMCPServer(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) (bool, string, error) {
+	mcpServer := GetRHOAIMCPServer(instance)
+
+	image := mcpServer.Image
+	if image == "" {
+		image = apiv1beta1.OpenShiftAILightspeedDefaultValues.RHOAIMCPServerImageURL
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RHOAIMCPServerName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, helper.GetClient(), serviceAccount, func() error {
+		return controllerutil.SetControllerReference(instance, serviceAccount, helper.GetScheme())
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	err = ensureRHOAIMCPServerAccess(ctx, helper, reader, instance)
+	if err != nil {
+		return false, "", err
+	}
+
+	podLabels := map[string]string{
+		"app.kubernetes.io/name":     RHOAIMCPServerName,
+		"app.kubernetes.io/instance": instance.Name,
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RHOAIMCPServerName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), deployment, func() error {
+		deployment.Spec.Replicas = ptr.To(int32(1))
+		// The selector is immutable, it is only set when the Deployment is created
+		if deployment.CreationTimestamp.IsZero() {
+			deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: podLabels}
+		}
+		deployment.Spec.Template.Labels = podLabels
+		deployment.Spec.Template.Spec.ServiceAccountName = serviceAccount.Name
+
+		// The container is updated in place, so the fields defaulted by the API server do not trigger updates
+		if len(deployment.Spec.Template.Spec.Containers) != 1 {
+			deployment.Spec.Template.Spec.Containers = []corev1.Container{{}}
+		}
+		container := &deployment.Spec.Template.Spec.Containers[0]
+		container.Name = "mcp-server"
+		container.Image = image
+		container.Env = []corev1.EnvVar{
+			{Name: "MCP_TRANSPORT", Value: "streamable-http"},
+			{Name: "MCP_PORT", Value: fmt.Sprint(rhoaiMCPServerPort)},
+			{Name: "MCP_READ_ONLY", Value: "true"},
+		}
+		container.Ports = []corev1.ContainerPort{
+			{Name: "http", ContainerPort: rhoaiMCPServerPort, Protocol: corev1.ProtocolTCP},
+		}
+		container.Resources = corev1.ResourceRequirements{}
+		if mcpServer.Resources != nil {
+			container.Resources = *mcpServer.Resources
+		}
+		container.SecurityContext = &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			RunAsNonRoot:             ptr.To(true),
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		}
+
+		return controllerutil.SetControllerReference(instance, deployment, helper.GetScheme())
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RHOAIMCPServerName,
+			Namespace: instance.Namespace,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, helper.GetClient(), service, func() error {
+		service.Spec.Selector = podLabels
+		service.Spec.Ports = []corev1.ServicePort{
+			{
+				Name:       "http",
+				Port:       rhoaiMCPServerPort,
+				TargetPort: intstr.FromInt32(rhoaiMCPServerPort),
+				Protocol:   corev1.ProtocolTCP,
+			},
+		}
+
+		return controllerutil.SetControllerReference(instance, service, helper.GetScheme())
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation || deployment.Status.AvailableReplicas == 0 {
+		return false, "Deployment has no available replica", nil
+	}
+
+	return true, "", nil
+}
+
+// ensureRHOAIMCPServerAccess creates or updates the ClusterRole and the ClusterRoleBinding granting the
+// ServiceAccount of the RHOAI MCP server read-only access to the OpenShift AI resources. Cluster scoped
+// objects cannot be owned by the instance, they are labeled with its UID instead.
+func ensureRHOAIMCPServerAccess(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	// ClusterRoles and ClusterRoleBindings are cluster scoped
+	uncachedClient := GetUncachedClient(helper, reader)
+
+	ownerLabels := map[string]string{
+		OpenShiftAILightspeedOwnerIDLabel: string(instance.GetUID()),
+	}
+
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: GetRHOAIMCPServerAccessName(instance),
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, uncachedClient, clusterRole, func() error {
+		clusterRole.SetLabels(ownerLabels)
+		clusterRole.Rules = RHOAIMCPServerRules
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: GetRHOAIMCPServerAccessName(instance),
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, uncachedClient, clusterRoleBinding, func() error {
+		clusterRoleBinding.SetLabels(ownerLabels)
+		clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		}
+		clusterRoleBinding.Subjects = []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      RHOAIMCPServerName,
+				Namespace: instance.Namespace,
+			},
+		}
+		return nil
+	})
+
+	return err
+}
+
+// RemoveRHOAIMCPServer deletes the objects created by EnsureRHOAIMCPServer for the instance.
+func RemoveRHOAIMCPServer(
+	ctx context.Context,
+	helper *common_helper.Helper,
+	reader client.Reader,
+	instance *apiv1beta1.OpenShiftAILightspeed,
+) error {
+	accessName := GetRHOAIMCPServerAccessName(instance)
+	err := RemoveInstanceLabeledClusterObject(ctx, helper, reader, instance, &rbacv1.ClusterRoleBinding{}, accessName)
+	if err != nil {
+		return err
+	}
+
+	err = RemoveInstanceLabeledClusterObject(ctx, helper, reader, instance, &rbacv1.ClusterRole{}, accessName)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range []client.Object{&appsv1.Deployment{}, &corev1.Service{}, &corev1.ServiceAccount{}} {
+		err = helper.GetClient().Get(ctx, client.ObjectKey{Name: RHOAIMCPServerName, Namespace: instance.Namespace}, object)
+		if err != nil && k8s_errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !IsOwnedBy(object, instance) {
+			continue
+		}
+
+		err = helper.GetClient().Delete(ctx, object)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRHOAIMCPServerAccessName returns the name of the ClusterRole and the ClusterRoleBinding of the RHOAI MCP
+// server. The first 5 characters of the instance's UID are appended to avoid collisions between instances.
+func GetRHOAIMCPServerAccessName(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	return fmt.Sprintf("%s-%s", RHOAIMCPServerName, string(instance.GetUID())[:5])
+}
+
+// GetRHOAIMCPServerURL returns the URL of the MCP endpoint of the RHOAI MCP server.
+func GetRHOAIMCPServerURL(instance *apiv1beta1.OpenShiftAILightspeed) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d/mcp", RHOAIMCPServerName, instance.Namespace, rhoaiMCPServerPort)
+}
+
+// PatchOLSTools sets the introspection, the MCP servers and the MCP server feature gate of the OLSConfig from
+// Tools, or removes them when they are not set. Other feature gates are kept.
+func PatchOLSTools(instance *apiv1beta1.OpenShiftAILightspeed, olsConfig *uns.Unstructured) error {
+	tools := instance.Spec.Tools
+	if tools == nil {
+		tools = &apiv1beta1.ToolsSpec{}
+	}
+
+	if tools.Introspection {
+		err := uns.SetNestedField(olsConfig.Object, true, "spec", "ols", "introspectionEnabled")
+		if err != nil {
+			return err
+		}
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "ols", "introspectionEnabled")
+	}
+
+	mcpServers := []interface{}{}
+	if tools.RHOAIMCPServer != nil {
+		mcpServers = append(mcpServers, getOLSMCPServer(RHOAIMCPServerName, GetRHOAIMCPServerURL(instance), nil))
+	}
+	for _, mcpServer := range tools.MCPServers {
+		mcpServers = append(mcpServers, getOLSMCPServer(mcpServer.Name, mcpServer.URL, mcpServer.TimeoutSeconds))
+	}
+
+	featureGates, _, err := uns.NestedStringSlice(olsConfig.Object, "spec", "featureGates")
+	if err != nil {
+		return err
+	}
+	featureGates = slices.DeleteFunc(featureGates, func(featureGate string) bool {
+		return featureGate == mcpServerFeatureGate
+	})
+
+	if len(mcpServers) > 0 {
+		err = uns.SetNestedSlice(olsConfig.Object, mcpServers, "spec", "mcpServers")
+		if err != nil {
+			return err
+		}
+		featureGates = append(featureGates, mcpServerFeatureGate)
+	} else {
+		uns.RemoveNestedField(olsConfig.Object, "spec", "mcpServers")
+	}
+
+	if len(featureGates) > 0 {
+		return uns.SetNestedStringSlice(olsConfig.Object, featureGates, "spec", "featureGates")
+	}
+
+	uns.RemoveNestedField(olsConfig.Object, "spec", "featureGates")
+	return nil
+}
+
+// getOLSMCPServer returns an MCP server of the OLSConfig reached over the streamable HTTP transport.
+func getOLSMCPServer(name string, url string, timeoutSeconds *int32) map[string]interface{} {
+	streamableHTTP := map[string]interface{}{
+		"url": url,
+	}
+	if timeoutSeconds != nil {
+		streamableHTTP["timeout"] = int64(*timeoutSeconds)
+	}
+
+	return map[string]interface{}{
+		"name":           name,
+		"streamableHTTP": streamableHTTP,
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/blang/semver/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiv1beta1 "github.com/opendatahub-io/openshift-ai-lightspeed-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Tools", func() {
+	It("should only support tool calling on recent OLS operators", func() {
+		Expect(IsToolCallingSupported(semver.MustParse("1.0.4"))).To(BeFalse())
+		Expect(IsToolCallingSupported(semver.MustParse(OLSToolCallingMinVersion))).To(BeTrue())
+		Expect(IsToolCallingSupported(semver.MustParse(OLSToolCallingMinVersion + "-rc.1"))).To(BeTrue())
+		Expect(IsToolCallingSupported(semver.MustParse("1.1.0"))).To(BeTrue())
+	})
+
+	It("should reject MCP servers named like the RHOAI MCP server", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{}
+		instance.Spec.Tools = &apiv1beta1.ToolsSpec{
+			MCPServers: []apiv1beta1.MCPServer{{Name: "pipelines", URL: "http://pipelines-mcp:8080/mcp"}},
+		}
+		Expect(ValidateTools(instance)).To(Succeed())
+
+		instance.Spec.Tools.MCPServers[0].Name = RHOAIMCPServerName
+		Expect(ValidateTools(instance)).NotTo(Succeed())
+	})
+
+	It("should set and remove the tools of the OLSConfig", func() {
+		instance := &apiv1beta1.OpenShiftAILightspeed{
+			ObjectMeta: metav1.ObjectMeta{Name: "lightspeed", Namespace: "openshift-lightspeed"},
+		}
+		instance.Spec.Tools = &apiv1beta1.ToolsSpec{
+			Introspection:  true,
+			RHOAIMCPServer: &apiv1beta1.RHOAIMCPServerSpec{},
+			MCPServers: []apiv1beta1.MCPServer{
+				{Name: "pipelines", URL: "http://pipelines-mcp:8080/mcp", TimeoutSeconds: ptr.To(int32(30))},
+			},
+		}
+		olsConfig := &uns.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"featureGates": []interface{}{"OtherFeature"},
+			},
+		}}
+		Expect(PatchOLSTools(instance, olsConfig)).To(Succeed())
+
+		introspectionEnabled, _, _ := uns.NestedBool(olsConfig.Object, "spec", "ols", "introspectionEnabled")
+		Expect(introspectionEnabled).To(BeTrue())
+		mcpServers, _, _ := uns.NestedSlice(olsConfig.Object, "spec", "mcpServers")
+		Expect(mcpServers).To(HaveLen(2))
+		Expect(mcpServers[0]).To(HaveKeyWithValue("name", RHOAIMCPServerName))
+		Expect(mcpServers[0]).To(HaveKeyWithValue("streamableHTTP", HaveKeyWithValue("url",
+			"http://openshift-ai-lightspeed-rhoai-mcp.openshift-lightspeed.svc:8080/mcp")))
+		Expect(mcpServers[1]).To(HaveKeyWithValue("streamableHTTP", HaveKeyWithValue("timeout", int64(30))))
+		featureGates, _, _ := uns.NestedStringSlice(olsConfig.Object, "spec", "featureGates")
+		Expect(featureGates).To(Equal([]string{"OtherFeature", mcpServerFeatureGate}))
+
+		instance.Spec.Tools = nil
+		Expect(PatchOLSTools(instance, olsConfig)).To(Succeed())
+		_, found, _ := uns.NestedFieldNoCopy(olsConfig.Object, "spec", "ols", "introspectionEnabled")
+		Expect(found).To(BeFalse())
+		_, found, _ = uns.NestedFieldNoCopy(olsConfig.Object, "spec", "mcpServers")
+		Expect(found).To(BeFalse())
+		featureGates, _, _ = uns.NestedStringSlice(olsConfig.Object, "spec", "featureGates")
+		Expect(featureGates).To(Equal([]string{"OtherFeature"}))
+	})
+})
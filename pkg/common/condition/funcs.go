@@ -95,6 +95,16 @@ func (c *Conditions) Set(condition Condition) {
 	*c = append(*c, condition)
 }
 
+// Remove removes the condition with the given type from the list, if it exists
+func (c *Conditions) Remove(t Type) {
+	for i, existing := range *c {
+		if existing.Type == t {
+			*c = append((*c)[:i], (*c)[i+1:]...)
+			return
+		}
+	}
+}
+
 // Get returns the condition with the given type, if it exists
 func (c Conditions) Get(t Type) *Condition {
 	for i := range c {